@@ -0,0 +1,35 @@
+package result
+
+import (
+	"fmt"
+)
+
+// Annotate adds context to the error of v, without stopping execution. If v is an error, Annotate returns an error Val
+// whose error is annotation followed by v's error, which it wraps. If v is ok, it's returned unchanged
+func Annotate[T any](v Val[T], annotation string) Val[T] {
+	if v.err == nil {
+		return v
+	}
+	return ValError[T](fmt.Errorf("%v: %w", annotation, v.err))
+}
+
+// Annotate is the chainable form of the Annotate function. Usage:
+//     user := loadUser(id).
+//         Annotate(fmt.Sprintf("user %v", id)).
+//         OrError("Couldn't load user")
+func (v Val[T]) Annotate(annotation string) Val[T] {
+	return Annotate(v, annotation)
+}
+
+// WrapError is the same as Annotate: it wraps the error of v with context, and returns v unchanged if it's ok
+func WrapError[T any](v Val[T], context string) Val[T] {
+	return Annotate(v, context)
+}
+
+// WrapStatus wraps the error of s with context, like Annotate does for a Val. If s is ok, it's returned unchanged
+func WrapStatus(s Status, context string) Status {
+	if s.err == nil {
+		return s
+	}
+	return Errorf("%v: %w", context, s.err)
+}
@@ -0,0 +1,53 @@
+package result_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// valErr returns the error held by v, or nil if v is ok
+func valErr[T any](v result.Val[T]) (err error) {
+	defer result.HandleReturn()
+	v.OrDoAndReturn(func(e error) {
+		err = e
+	})
+	return nil
+}
+
+func TestAnnotateOk(t *testing.T) {
+	v := result.NewVal(1)
+	assert.Equal(t, v, result.Annotate(v, "Context"))
+	assert.Equal(t, v, v.Annotate("Context"))
+}
+
+func TestAnnotateChain(t *testing.T) {
+	v := result.ValError[int](io.EOF).
+		Annotate("inner").
+		Annotate("outer")
+	assert.EqualError(t, v, "outer: inner: EOF")
+
+	err := valErr(v)
+	assert.EqualError(t, err, "outer: inner: EOF")
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "inner: EOF")
+	err = errors.Unwrap(err)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestWrapError(t *testing.T) {
+	v := result.WrapError(result.ValError[int](io.EOF), "Context")
+	assert.EqualError(t, v, "Context: EOF")
+	assert.True(t, errors.Is(v, io.EOF))
+	assert.Equal(t, result.NewVal(1), result.WrapError(result.NewVal(1), "Context"))
+}
+
+func TestWrapStatus(t *testing.T) {
+	s := result.WrapStatus(result.Error(io.EOF), "Context")
+	assert.EqualError(t, s, "Context: EOF")
+	assert.True(t, errors.Is(s, io.EOF))
+	assert.Equal(t, result.Ok(), result.WrapStatus(result.Ok(), "Context"))
+}
@@ -0,0 +1,106 @@
+package result
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// SafeGo calls f in a new goroutine, and returns a channel that delivers its result, then closes. If f panics, the
+// panic is recovered and delivered as an error Val. The channel is buffered, so the goroutine finishes even if the
+// result is never received. Usage:
+//     pricesCh := result.SafeGo(fetchPrices)
+//     // ...
+//     prices := result.Await(pricesCh).
+//         OrError("Couldn't get prices")
+func SafeGo[T any](f func() Val[T]) <-chan Val[T] {
+	ch := make(chan Val[T], 1)
+	go func() {
+		defer close(ch)
+		ch <- safeCall(f)
+	}()
+	return ch
+}
+
+// Await blocks until ch delivers a result, and returns it. If ch is closed without delivering a result, Await returns
+// an error Val. Usage:
+//     prices := result.Await(pricesCh).
+//         OrError("Couldn't get prices")
+func Await[T any](ch <-chan Val[T]) Val[T] {
+	v, ok := <-ch
+	if !ok {
+		return ValErrorf[T]("channel closed without delivering a value")
+	}
+	return v
+}
+
+// AwaitContext is like Await, but stops waiting and returns an error Val wrapping ctx.Err() if ctx is done first
+func AwaitContext[T any](ctx context.Context, ch <-chan Val[T]) Val[T] {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return ValErrorf[T]("channel closed without delivering a value")
+		}
+		return v
+	case <-ctx.Done():
+		return ValErrorf[T]("Stopped waiting for a value: %w", ctx.Err())
+	}
+}
+
+// AwaitAny blocks until any of chs delivers a result, and returns the first one delivered, whether it's ok or an
+// error. Channels that are closed without delivering a result are ignored. If all of chs are closed without delivering
+// a result, AwaitAny returns an error Val
+func AwaitAny[T any](chs ...<-chan Val[T]) Val[T] {
+	cases := make([]reflect.SelectCase, len(chs))
+	for i, ch := range chs {
+		cases[i] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ch),
+		}
+	}
+	for open := len(cases); open > 0; open-- {
+		i, v, ok := reflect.Select(cases)
+		if ok {
+			return v.Interface().(Val[T])
+		}
+		// A nil channel blocks forever, so the closed channel is never selected again
+		cases[i].Chan = reflect.ValueOf((<-chan Val[T])(nil))
+	}
+	return ValErrorf[T]("all %v channels closed without delivering a value", len(chs))
+}
+
+// FromChan receives a value from ch and returns it as an ok Val. If ch is closed without delivering a value, or no value
+// arrives within timeout, FromChan returns an error Val. A timeout of 0 or less means no timeout, so FromChan
+// waits forever. Usage:
+//     msg := result.FromChan(msgs, 5*time.Second).
+//         OrError("Didn't get a message")
+func FromChan[T any](ch <-chan T, timeout time.Duration) Val[T] {
+	if timeout <= 0 {
+		return FromChanContext(context.Background(), ch)
+	}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return ValErrorf[T]("channel closed without delivering a value")
+		}
+		return NewVal(v)
+	case <-t.C:
+		return ValErrorf[T]("Timed out after %v waiting for a value", timeout)
+	}
+}
+
+// FromChanContext is like FromChan, but stops waiting and returns an error Val wrapping ctx.Err() when ctx is done
+// instead of after a timeout
+func FromChanContext[T any](ctx context.Context, ch <-chan T) Val[T] {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return ValErrorf[T]("channel closed without delivering a value")
+		}
+		return NewVal(v)
+	case <-ctx.Done():
+		return ValErrorf[T]("Stopped waiting for a value: %w", ctx.Err())
+	}
+}
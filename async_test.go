@@ -0,0 +1,113 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwait(t *testing.T) {
+	ch := make(chan result.Val[int])
+	go func() {
+		ch <- result.NewVal(1)
+	}()
+	assert.Equal(t, 1, result.Await(ch).OrPanic("Unexpected error"))
+}
+
+func TestAwaitClosed(t *testing.T) {
+	ch := make(chan result.Val[int])
+	close(ch)
+	assert.EqualError(t, result.Await(ch), "channel closed without delivering a value")
+}
+
+func TestAwaitContext(t *testing.T) {
+	ch := make(chan result.Val[int], 1)
+	ch <- result.NewVal(1)
+	assert.Equal(t, 1, result.AwaitContext(context.Background(), ch).OrPanic("Unexpected error"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	assert.EqualError(
+		t,
+		result.AwaitContext(ctx, make(chan result.Val[int])),
+		"Stopped waiting for a value: context canceled",
+	)
+}
+
+func TestAwaitAny(t *testing.T) {
+	slow := make(chan result.Val[string], 1)
+	fast := make(chan result.Val[string], 1)
+	closed := make(chan result.Val[string])
+	close(closed)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		slow <- result.NewVal("slow")
+	}()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		fast <- result.NewVal("fast")
+	}()
+	assert.Equal(t, "fast", result.AwaitAny(slow, closed, fast).OrPanic("Unexpected error"))
+}
+
+func TestAwaitAnyAllClosed(t *testing.T) {
+	a := make(chan result.Val[int])
+	b := make(chan result.Val[int])
+	close(a)
+	close(b)
+	assert.EqualError(t, result.AwaitAny(a, b), "all 2 channels closed without delivering a value")
+}
+
+func TestSafeGo(t *testing.T) {
+	ch := result.SafeGo(func() result.Val[int] {
+		return result.NewVal(1)
+	})
+	assert.Equal(t, 1, result.Await(ch).OrPanic("Unexpected error"))
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestSafeGoPanic(t *testing.T) {
+	ch := result.SafeGo(func() result.Val[int] {
+		panic("Expected panic")
+	})
+	assert.EqualError(t, result.Await(ch), "panic: Expected panic")
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	assert.Equal(t, 1, result.FromChan(ch, time.Second).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromChan(ch, 10*time.Millisecond), "Timed out after 10ms waiting for a value")
+	close(ch)
+	assert.EqualError(t, result.FromChan(ch, time.Second), "channel closed without delivering a value")
+}
+
+func TestFromChanNoTimeout(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		ch <- 1
+	}()
+	assert.Equal(t, 1, result.FromChan(ch, 0).OrPanic("Unexpected error"))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		ch <- 2
+	}()
+	assert.Equal(t, 2, result.FromChan(ch, -time.Second).OrPanic("Unexpected error"))
+}
+
+func TestFromChanContext(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	assert.Equal(t, 1, result.FromChanContext(context.Background(), ch).OrPanic("Unexpected error"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.EqualError(t, result.FromChanContext(ctx, ch), "Stopped waiting for a value: context canceled")
+}
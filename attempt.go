@@ -0,0 +1,28 @@
+package result
+
+// AttemptAll calls every one of attempts in order, even after some fail, and returns all of their results in the same
+// order, along with whether they were all ok. Usage:
+//     results, allOk := result.AttemptAll(uploads)
+//     if !allOk {
+//         reportFailures(results)
+//     }
+func AttemptAll[T any](attempts []func() Val[T]) ([]Val[T], bool) {
+	vs := make([]Val[T], len(attempts))
+	allOk := true
+	for i, attempt := range attempts {
+		vs[i] = attempt()
+		allOk = allOk && vs[i].err == nil
+	}
+	return vs, allOk
+}
+
+// AttemptAllStatus is like AttemptAll, for attempts that return a Status
+func AttemptAllStatus(attempts []func() Status) ([]Status, bool) {
+	ss := make([]Status, len(attempts))
+	allOk := true
+	for i, attempt := range attempts {
+		ss[i] = attempt()
+		allOk = allOk && ss[i].err == nil
+	}
+	return ss, allOk
+}
@@ -0,0 +1,70 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// attempts returns an attempt for each of is, counting calls in *calls. Negative numbers fail
+func attempts(calls *int, is ...int) []func() result.Val[int] {
+	fs := []func() result.Val[int]{}
+	for _, i := range is {
+		i := i
+		fs = append(fs, func() result.Val[int] {
+			*calls++
+			if i < 0 {
+				return result.ValErrorf[int]("Error %v", i)
+			}
+			return result.NewVal(i)
+		})
+	}
+	return fs
+}
+
+func TestAttemptAll(t *testing.T) {
+	calls := 0
+	vs, allOk := result.AttemptAll(attempts(&calls, 1, 2))
+	assert.True(t, allOk)
+	assert.Equal(t, []string{"1", "2"}, setStrings(vs))
+
+	calls = 0
+	vs, allOk = result.AttemptAll(attempts(&calls, -1, -2))
+	assert.False(t, allOk)
+	assert.Equal(t, []string{"Error -1", "Error -2"}, setStrings(vs))
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	vs, allOk = result.AttemptAll(attempts(&calls, -1, 2, -3))
+	assert.False(t, allOk)
+	assert.Equal(t, []string{"Error -1", "2", "Error -3"}, setStrings(vs))
+	assert.Equal(t, 3, calls)
+}
+
+func TestAttemptAllEmpty(t *testing.T) {
+	vs, allOk := result.AttemptAll([]func() result.Val[int]{})
+	assert.True(t, allOk)
+	assert.Empty(t, vs)
+}
+
+func TestAttemptAllStatus(t *testing.T) {
+	calls := 0
+	ok := func() result.Status {
+		calls++
+		return result.Ok()
+	}
+	fail := func() result.Status {
+		calls++
+		return result.Errorf("Expected error")
+	}
+	ss, allOk := result.AttemptAllStatus([]func() result.Status{fail, ok, fail})
+	assert.False(t, allOk)
+	assert.Len(t, ss, 3)
+	assert.True(t, ss[1].Ok())
+	assert.Equal(t, 3, calls)
+
+	ss, allOk = result.AttemptAllStatus(nil)
+	assert.True(t, allOk)
+	assert.Empty(t, ss)
+}
@@ -1,5 +1,9 @@
 package result
 
+import (
+	"errors"
+)
+
 type errorSetter interface {
 	setError(error)
 }
@@ -25,3 +29,28 @@ func (b base) Error() string {
 	}
 	return b.err.Error()
 }
+
+// Err returns the result's error, or nil if the result is ok
+func (b base) Err() error {
+	return b.err
+}
+
+// Unwrap returns the result's underlying error, or nil if the result is ok. Since results also have an Error method,
+// this lets errors.Is and errors.As look inside a result, e.g:
+//     if errors.Is(v, io.EOF) {
+//         // ...
+//     }
+func (b base) Unwrap() error {
+	return b.err
+}
+
+// Is returns whether the result's error matches target, as defined by errors.Is. It's always false if the result is ok
+func (b base) Is(target error) bool {
+	return errors.Is(b.err, target)
+}
+
+// As finds the first error in the result's error chain that matches target, as defined by errors.As. It's always false
+// if the result is ok
+func (b base) As(target any) bool {
+	return b.err != nil && errors.As(b.err, target)
+}
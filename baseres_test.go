@@ -0,0 +1,53 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrap(t *testing.T) {
+	assert.Nil(t, result.NewVal(1).Unwrap())
+	assert.Nil(t, result.Ok().Unwrap())
+	assert.Equal(t, io.EOF, result.ValError[int](io.EOF).Unwrap())
+	assert.Equal(t, io.EOF, result.ValsError[int, int](io.EOF).Unwrap())
+}
+
+func TestErrorsIs(t *testing.T) {
+	v := result.ValError[int](fmt.Errorf("Context: %w", io.EOF))
+	assert.True(t, errors.Is(v, io.EOF))
+	assert.True(t, v.Is(io.EOF))
+	assert.False(t, v.Is(io.ErrUnexpectedEOF))
+	assert.False(t, result.NewVal(1).Is(io.EOF))
+	assert.True(t, errors.Is(result.Error(io.EOF), io.EOF))
+}
+
+func TestErrorsAs(t *testing.T) {
+	s := result.Error(fmt.Errorf("Context: %w", &fs.PathError{Op: "open", Path: "a", Err: fs.ErrNotExist}))
+	var pathErr *fs.PathError
+	assert.True(t, errors.As(s, &pathErr))
+	assert.Equal(t, "a", pathErr.Path)
+
+	pathErr = nil
+	assert.True(t, s.As(&pathErr))
+	assert.Equal(t, "a", pathErr.Path)
+
+	assert.False(t, result.Ok().As(&pathErr))
+	assert.False(t, result.ValErrorf[int]("Other error").As(&pathErr))
+}
+
+func TestErr(t *testing.T) {
+	assert.Nil(t, result.Ok().Err())
+	assert.Nil(t, result.NewVal(1).Err())
+	assert.Nil(t, result.NewVals(1, 2).Err())
+	err := errors.New("Expected error")
+	assert.True(t, result.Error(err).Err() == err)
+	assert.True(t, result.ValError[int](err).Err() == err)
+	assert.True(t, result.ValsError[int, int](err).Err() == err)
+	assert.True(t, errors.Is(result.ValErrorf[int]("Context: %w", io.EOF).Err(), io.EOF))
+}
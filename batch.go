@@ -0,0 +1,87 @@
+package result
+
+import (
+	"sync"
+)
+
+// Batch splits inputs into chunks of batchSize, calls process for each chunk in order, and returns all of the outputs
+// concatenated in order. The last chunk may be smaller than batchSize. If process returns an error Val for any chunk,
+// Batch stops and returns that error. Usage:
+//     ids := result.Batch(users, 100, bulkInsert).
+//         OrError("Couldn't insert users")
+func Batch[T, U any](inputs []T, batchSize int, process func([]T) Val[[]U]) Val[[]U] {
+	if batchSize <= 0 {
+		return ValErrorf[[]U]("Batch size must be positive, got %v", batchSize)
+	}
+	outputs := []U{}
+	for _, chunk := range chunks(inputs, batchSize) {
+		v := process(chunk)
+		if v.err != nil {
+			return v
+		}
+		outputs = append(outputs, v.v...)
+	}
+	return NewVal(outputs)
+}
+
+// BatchConcurrent is like Batch, but processes up to maxConcurrent chunks at the same time. Outputs are still returned
+// in the order of inputs. If any chunk fails, no new chunks are started, and the error of the earliest failed chunk is
+// returned
+func BatchConcurrent[T, U any](inputs []T, batchSize, maxConcurrent int, process func([]T) Val[[]U]) Val[[]U] {
+	if batchSize <= 0 {
+		return ValErrorf[[]U]("Batch size must be positive, got %v", batchSize)
+	}
+	if maxConcurrent <= 0 {
+		return ValErrorf[[]U]("Max concurrency must be positive, got %v", maxConcurrent)
+	}
+	cs := chunks(inputs, batchSize)
+	results := make([]Val[[]U], len(cs))
+	sem := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := false
+	for i, chunk := range cs {
+		sem <- struct{}{}
+		mu.Lock()
+		stop := failed
+		mu.Unlock()
+		if stop {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(i int, chunk []T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			v := process(chunk)
+			results[i] = v
+			if v.err != nil {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(i, chunk)
+	}
+	wg.Wait()
+	outputs := []U{}
+	for _, v := range results {
+		if v.err != nil {
+			return v
+		}
+		outputs = append(outputs, v.v...)
+	}
+	return NewVal(outputs)
+}
+
+// chunks splits s into consecutive slices of length n. The last one may be shorter
+func chunks[T any](s []T, n int) [][]T {
+	cs := [][]T{}
+	for len(s) > n {
+		cs = append(cs, s[:n:n])
+		s = s[n:]
+	}
+	if len(s) > 0 {
+		cs = append(cs, s)
+	}
+	return cs
+}
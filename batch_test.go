@@ -0,0 +1,87 @@
+package result_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func batchDouble(chunk []int) result.Val[[]int] {
+	out := make([]int, len(chunk))
+	for i, n := range chunk {
+		if n < 0 {
+			return result.ValErrorf[[]int]("Negative input %v", n)
+		}
+		out[i] = n * 2
+	}
+	return result.NewVal(out)
+}
+
+func TestBatch(t *testing.T) {
+	sizes := []int{}
+	out := result.Batch([]int{1, 2, 3, 4, 5}, 2, func(chunk []int) result.Val[[]int] {
+		sizes = append(sizes, len(chunk))
+		return batchDouble(chunk)
+	}).OrPanic("Unexpected error")
+	assert.Equal(t, []int{2, 4, 6, 8, 10}, out)
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+func TestBatchEmpty(t *testing.T) {
+	out := result.Batch([]int{}, 2, batchDouble).
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{}, out)
+}
+
+func TestBatchInvalidSize(t *testing.T) {
+	assert.EqualError(t, result.Batch([]int{1}, 0, batchDouble), "Batch size must be positive, got 0")
+}
+
+func TestBatchFailFast(t *testing.T) {
+	calls := 0
+	v := result.Batch([]int{1, 2, -3, 4, 5}, 2, func(chunk []int) result.Val[[]int] {
+		calls++
+		return batchDouble(chunk)
+	})
+	assert.EqualError(t, v, "Negative input -3")
+	assert.Equal(t, 2, calls)
+}
+
+func TestBatchConcurrent(t *testing.T) {
+	inputs := make([]int, 100)
+	expected := make([]int, 100)
+	for i := range inputs {
+		inputs[i] = i
+		expected[i] = i * 2
+	}
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	out := result.BatchConcurrent(inputs, 7, 3, func(chunk []int) result.Val[[]int] {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		return batchDouble(chunk)
+	}).OrPanic("Unexpected error")
+	assert.Equal(t, expected, out)
+	assert.LessOrEqual(t, maxRunning, 3)
+}
+
+func TestBatchConcurrentError(t *testing.T) {
+	v := result.BatchConcurrent([]int{1, 2, 3, -4, 5, -6}, 1, 2, batchDouble)
+	assert.EqualError(t, v, "Negative input -4")
+	assert.EqualError(
+		t,
+		result.BatchConcurrent([]int{1}, 1, 0, batchDouble),
+		"Max concurrency must be positive, got 0",
+	)
+}
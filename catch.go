@@ -0,0 +1,22 @@
+package result
+
+import (
+	"log"
+)
+
+// Catch is an experimental alternative to Handle and OrUse. It returns the value of v (or the zero value if v is an
+// error) along with a catch function. Calling catch with some context logs the context and the error with the standard
+// log package if v is an error, and does nothing if v is ok. Either way, catch returns the same value as Catch. Usage:
+//     a, catch := result.Catch(calcA())
+//     a = catch("Couldn't calculate a") // logs only if calcA returned an error
+func Catch[T any](v Val[T]) (t T, catch func(string) T) {
+	if v.err == nil {
+		return v.v, func(string) T {
+			return v.v
+		}
+	}
+	return t, func(context string) T {
+		log.Printf("%v: %v", context, v.err)
+		return t
+	}
+}
@@ -0,0 +1,39 @@
+package result_test
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func catchLog(t *testing.T) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	log.SetOutput(b)
+	flags := log.Flags()
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(flags)
+	})
+	return b
+}
+
+func TestCatchOk(t *testing.T) {
+	b := catchLog(t)
+	a, catch := result.Catch(result.NewVal(5))
+	assert.Equal(t, 5, a)
+	assert.Equal(t, 5, catch("Couldn't calculate a"))
+	assert.Empty(t, b.String())
+}
+
+func TestCatchError(t *testing.T) {
+	b := catchLog(t)
+	a, catch := result.Catch(result.ValErrorf[int]("Expected error"))
+	assert.Equal(t, 0, a)
+	assert.Equal(t, 0, catch("Couldn't calculate a"))
+	assert.Equal(t, "Couldn't calculate a: Expected error\n", b.String())
+}
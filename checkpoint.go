@@ -0,0 +1,27 @@
+package result
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LevelTrace is the slog level Checkpoint logs ok results at. It's below slog.LevelDebug, so ok results are only logged
+// by loggers that opt into it
+const LevelTrace = slog.LevelDebug - 4
+
+// Checkpoint logs v to log and returns it unchanged, to help find which step of a pipeline failed. Error results are
+// logged at slog.LevelDebug with label and the error; ok results are logged at LevelTrace with only label. If log is
+// nil, slog.Default() is used. Usage:
+//     user := result.Checkpoint(fetchUser(id), "after fetch", log).
+//         OrError("Couldn't fetch user")
+func Checkpoint[T any](v Val[T], label string, log *slog.Logger) Val[T] {
+	if log == nil {
+		log = slog.Default()
+	}
+	if v.err != nil {
+		log.Debug("result checkpoint", "label", label, "error", v.err)
+		return v
+	}
+	log.Log(context.Background(), LevelTrace, "result checkpoint", "label", label)
+	return v
+}
@@ -0,0 +1,40 @@
+package result_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func checkpointLogger(level slog.Level) (*slog.Logger, *bytes.Buffer) {
+	b := &bytes.Buffer{}
+	return slog.New(slog.NewTextHandler(b, &slog.HandlerOptions{Level: level})), b
+}
+
+func TestCheckpointError(t *testing.T) {
+	log, b := checkpointLogger(slog.LevelDebug)
+	v := result.Checkpoint(result.ValErrorf[int]("Expected error"), "after fetch", log)
+	assert.EqualError(t, v, "Expected error")
+	assert.Contains(t, b.String(), `label="after fetch"`)
+	assert.Contains(t, b.String(), `error="Expected error"`)
+}
+
+func TestCheckpointOk(t *testing.T) {
+	log, b := checkpointLogger(slog.LevelError)
+	v := result.Checkpoint(result.NewVal(1), "after fetch", log)
+	assert.Equal(t, 1, v.OrPanic("Unexpected error"))
+	assert.NotContains(t, b.String(), "after fetch")
+
+	log, b = checkpointLogger(result.LevelTrace)
+	result.Checkpoint(result.NewVal(1), "after fetch", log)
+	assert.Contains(t, b.String(), `label="after fetch"`)
+}
+
+func TestCheckpointErrorBelowLevel(t *testing.T) {
+	log, b := checkpointLogger(slog.LevelError)
+	result.Checkpoint(result.ValErrorf[int]("Expected error"), "after fetch", log)
+	assert.Empty(t, b.String())
+}
@@ -0,0 +1,165 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is the error returned by CircuitBreaker.Call when the circuit is open, and the underlying function
+// wasn't called
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed means calls go through to the underlying function
+	CircuitClosed CircuitState = iota
+	// CircuitOpen means calls fail immediately without calling the underlying function
+	CircuitOpen
+	// CircuitHalfOpen means the cooldown has passed, and the next call will be let through as a probe
+	CircuitHalfOpen
+)
+
+// String returns the name of the state
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerConfig configures a CircuitBreaker
+type CircuitBreakerConfig struct {
+	// Threshold is the number of failures within Window that opens the circuit
+	Threshold int
+	// Window is how far back failures are counted. If it's 0, all failures since the circuit last closed are counted
+	Window time.Duration
+	// Cooldown is how long the circuit stays open before letting a probe call through
+	Cooldown time.Duration
+}
+
+// CircuitBreaker protects a downstream service from cascading failures. It calls its function until Threshold failures
+// happen within Window, then opens the circuit: calls fail immediately with ErrCircuitOpen. After Cooldown, the circuit
+// half-opens and lets one probe call through. If the probe succeeds the circuit closes; otherwise it opens again.
+// CircuitBreaker is safe for concurrent use
+type CircuitBreaker[T any] struct {
+	cfg      CircuitBreakerConfig
+	f        func() Val[T]
+	mu       sync.Mutex
+	state    CircuitState
+	failures []time.Time
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a new, closed CircuitBreaker that protects calls to f. Usage:
+//     cb := result.NewCircuitBreaker(cfg, fetchPrices)
+//     prices := cb.Call().
+//         OrError("Couldn't fetch prices")
+func NewCircuitBreaker[T any](cfg CircuitBreakerConfig, f func() Val[T]) *CircuitBreaker[T] {
+	return &CircuitBreaker[T]{
+		cfg: cfg,
+		f:   f,
+	}
+}
+
+// Call returns the result of calling the underlying function, or an error Val wrapping ErrCircuitOpen without calling
+// it if the circuit is open
+func (c *CircuitBreaker[T]) Call() Val[T] {
+	probe, ok := c.admit()
+	if !ok {
+		return ValError[T](ErrCircuitOpen)
+	}
+	v := c.f()
+	c.record(probe, v.err == nil)
+	return v
+}
+
+// State returns the current state of the circuit
+func (c *CircuitBreaker[T]) State() CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.currentState(time.Now())
+}
+
+// Reset manually closes the circuit and forgets all past failures
+func (c *CircuitBreaker[T]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = CircuitClosed
+	c.failures = nil
+	c.probing = false
+}
+
+// currentState returns the state, moving from open to half-open if the cooldown has passed. c.mu must be held
+func (c *CircuitBreaker[T]) currentState(now time.Time) CircuitState {
+	if c.state == CircuitOpen && now.Sub(c.openedAt) >= c.cfg.Cooldown {
+		c.state = CircuitHalfOpen
+	}
+	return c.state
+}
+
+// admit returns whether a call may go through, and if so whether it's the probe call of a half-open circuit
+func (c *CircuitBreaker[T]) admit() (probe bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch c.currentState(time.Now()) {
+	case CircuitOpen:
+		return false, false
+	case CircuitHalfOpen:
+		if c.probing {
+			return false, false
+		}
+		c.probing = true
+		return true, true
+	}
+	return false, true
+}
+
+// record updates the state of the circuit with the outcome of a call
+func (c *CircuitBreaker[T]) record(probe bool, success bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if probe {
+		c.probing = false
+		if c.state != CircuitHalfOpen {
+			return // Reset was called during the probe
+		}
+		if success {
+			c.state = CircuitClosed
+			c.failures = nil
+			return
+		}
+		c.open(now)
+		return
+	}
+	if success || c.state != CircuitClosed {
+		return
+	}
+	c.failures = append(c.failures, now)
+	if c.cfg.Window > 0 {
+		i := 0
+		for i < len(c.failures) && now.Sub(c.failures[i]) > c.cfg.Window {
+			i++
+		}
+		c.failures = c.failures[i:]
+	}
+	if len(c.failures) >= c.cfg.Threshold {
+		c.open(now)
+	}
+}
+
+// open opens the circuit. c.mu must be held
+func (c *CircuitBreaker[T]) open(now time.Time) {
+	c.state = CircuitOpen
+	c.openedAt = now
+	c.failures = nil
+}
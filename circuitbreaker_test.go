@@ -0,0 +1,110 @@
+package result_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// circuitFunc returns a func that fails while *fail is true, counting its calls in *calls
+func circuitFunc(fail *bool, calls *int) func() result.Val[int] {
+	return func() result.Val[int] {
+		*calls++
+		if *fail {
+			return result.ValErrorf[int]("Expected error")
+		}
+		return result.NewVal(1)
+	}
+}
+
+func TestCircuitBreakerClosed(t *testing.T) {
+	fail, calls := false, 0
+	cb := result.NewCircuitBreaker(
+		result.CircuitBreakerConfig{Threshold: 2, Window: time.Minute, Cooldown: time.Minute},
+		circuitFunc(&fail, &calls),
+	)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, 1, cb.Call().OrPanic("Unexpected error"))
+	}
+	assert.Equal(t, 5, calls)
+	assert.Equal(t, result.CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	fail, calls := true, 0
+	cb := result.NewCircuitBreaker(
+		result.CircuitBreakerConfig{Threshold: 2, Window: time.Minute, Cooldown: time.Minute},
+		circuitFunc(&fail, &calls),
+	)
+	cb.Call()
+	assert.Equal(t, result.CircuitClosed, cb.State())
+	cb.Call()
+	assert.Equal(t, result.CircuitOpen, cb.State())
+	assert.EqualError(t, cb.Call(), result.ErrCircuitOpen.Error())
+	assert.Equal(t, 2, calls)
+}
+
+func TestCircuitBreakerHalfOpenProbe(t *testing.T) {
+	fail, calls := true, 0
+	cb := result.NewCircuitBreaker(
+		result.CircuitBreakerConfig{Threshold: 1, Cooldown: 10 * time.Millisecond},
+		circuitFunc(&fail, &calls),
+	)
+	cb.Call()
+	assert.Equal(t, result.CircuitOpen, cb.State())
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, result.CircuitHalfOpen, cb.State())
+
+	// A failed probe opens the circuit again
+	cb.Call()
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, result.CircuitOpen, cb.State())
+
+	// A successful probe closes it
+	time.Sleep(20 * time.Millisecond)
+	fail = false
+	assert.Equal(t, 1, cb.Call().OrPanic("Unexpected error"))
+	assert.Equal(t, result.CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerReset(t *testing.T) {
+	fail, calls := true, 0
+	cb := result.NewCircuitBreaker(
+		result.CircuitBreakerConfig{Threshold: 1, Cooldown: time.Minute},
+		circuitFunc(&fail, &calls),
+	)
+	cb.Call()
+	assert.Equal(t, result.CircuitOpen, cb.State())
+	cb.Reset()
+	assert.Equal(t, result.CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	cb := result.NewCircuitBreaker(
+		result.CircuitBreakerConfig{Threshold: 3, Window: time.Minute, Cooldown: time.Millisecond},
+		func() result.Val[int] {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls%2 == 0 {
+				return result.ValErrorf[int]("Expected error")
+			}
+			return result.NewVal(calls)
+		},
+	)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cb.Call()
+			cb.State()
+		}()
+	}
+	wg.Wait()
+}
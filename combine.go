@@ -0,0 +1,92 @@
+package result
+
+import (
+	"errors"
+)
+
+// StatusAnd returns an ok Status if both a and b are ok. Otherwise, it returns the first error Status. Usage:
+//     result.StatusAnd(validateName(u), validateEmail(u)).
+//         OrError("Invalid user")
+func StatusAnd(a, b Status) Status {
+	return StatusAll(a, b)
+}
+
+// StatusOr returns an ok Status if either a or b is ok. If both are errors, it returns an error Status joining both
+// errors with errors.Join
+func StatusOr(a, b Status) Status {
+	return StatusAny(a, b)
+}
+
+// StatusAll returns an ok Status if all of statuses are ok, including if there are none. Otherwise, it returns the first
+// error Status
+func StatusAll(statuses ...Status) Status {
+	for _, s := range statuses {
+		if s.err != nil {
+			return s
+		}
+	}
+	return Ok()
+}
+
+// StatusAny returns an ok Status if any of statuses is ok. If all of them are errors, or there are none, it returns an
+// error Status joining all of their errors with errors.Join
+func StatusAny(statuses ...Status) Status {
+	if len(statuses) == 0 {
+		return Errorf("No statuses to check")
+	}
+	errs := make([]error, len(statuses))
+	for i, s := range statuses {
+		if s.err == nil {
+			return Ok()
+		}
+		errs[i] = s.err
+	}
+	return Error(errors.Join(errs...))
+}
+
+// StatusMerge returns an ok Status if all of statuses are ok. Otherwise, it returns an error Status joining the errors
+// of all of them with errors.Join. Unlike StatusAll, every error is reported, not just the first. Usage:
+//     result.StatusMerge(
+//         validateName(u),
+//         validateEmail(u),
+//         validateAge(u),
+//     ).
+//         OrError("Invalid user") // reports every invalid field
+func StatusMerge(statuses ...Status) Status {
+	var errs []error
+	for _, s := range statuses {
+		if s.err != nil {
+			errs = append(errs, s.err)
+		}
+	}
+	if len(errs) == 0 {
+		return Ok()
+	}
+	return Error(errors.Join(errs...))
+}
+
+// ValOr returns a if it's ok. Otherwise, it returns b. Unlike OrElse, both a and b have already been computed. Usage:
+//     user := result.ValOr(cache.Get(id), replicaCache.Get(id)).
+//         OrError("User isn't cached")
+func ValOr[T any](a, b Val[T]) Val[T] {
+	if a.err == nil {
+		return a
+	}
+	return b
+}
+
+// FirstOk returns the first of vals that's ok. If all of them are errors, or there are none, it returns an error Val
+// joining all of their errors with errors.Join
+func FirstOk[T any](vals ...Val[T]) Val[T] {
+	if len(vals) == 0 {
+		return ValErrorf[T]("No values to check")
+	}
+	errs := make([]error, len(vals))
+	for i, v := range vals {
+		if v.err == nil {
+			return v
+		}
+		errs[i] = v.err
+	}
+	return ValError[T](errors.Join(errs...))
+}
@@ -0,0 +1,78 @@
+package result_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusAndCombinator(t *testing.T) {
+	assert.True(t, result.StatusAnd(result.Ok(), result.Ok()).Ok())
+	assert.EqualError(t, result.StatusAnd(result.Ok(), result.Errorf("Error b")), "Error b")
+	assert.EqualError(t, result.StatusAnd(result.Errorf("Error a"), result.Errorf("Error b")), "Error a")
+}
+
+func TestStatusOrCombinator(t *testing.T) {
+	assert.True(t, result.StatusOr(result.Ok(), result.Errorf("Error b")).Ok())
+	assert.True(t, result.StatusOr(result.Errorf("Error a"), result.Ok()).Ok())
+	assert.EqualError(t, result.StatusOr(result.Errorf("Error a"), result.Errorf("Error b")), "Error a\nError b")
+}
+
+func TestStatusAll(t *testing.T) {
+	assert.True(t, result.StatusAll().Ok())
+	assert.True(t, result.StatusAll(result.Ok(), result.Ok(), result.Ok()).Ok())
+	assert.EqualError(
+		t,
+		result.StatusAll(result.Ok(), result.Errorf("Error b"), result.Errorf("Error c")),
+		"Error b",
+	)
+}
+
+func TestStatusAny(t *testing.T) {
+	assert.EqualError(t, result.StatusAny(), "No statuses to check")
+	assert.True(t, result.StatusAny(result.Errorf("Error a"), result.Ok()).Ok())
+	assert.EqualError(
+		t,
+		result.StatusAny(result.Errorf("Error a"), result.Errorf("Error b"), result.Errorf("Error c")),
+		"Error a\nError b\nError c",
+	)
+}
+
+type fieldError struct {
+	field string
+}
+
+func (e fieldError) Error() string {
+	return "Invalid " + e.field
+}
+
+func TestStatusMerge(t *testing.T) {
+	assert.True(t, result.StatusMerge().Ok())
+	assert.True(t, result.StatusMerge(result.Ok(), result.Ok()).Ok())
+	s := result.StatusMerge(result.Error(io.EOF), result.Ok(), result.Error(fieldError{"name"}))
+	assert.EqualError(t, s, "EOF\nInvalid name")
+	assert.True(t, errors.Is(s, io.EOF))
+	var fe fieldError
+	assert.True(t, errors.As(s, &fe))
+	assert.Equal(t, "name", fe.field)
+}
+
+func TestValOr(t *testing.T) {
+	assert.Equal(t, 1, result.ValOr(result.NewVal(1), result.NewVal(2)).OrPanic("Unexpected error"))
+	assert.Equal(t, 2, result.ValOr(result.ValErrorf[int]("Error a"), result.NewVal(2)).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.ValOr(result.ValErrorf[int]("Error a"), result.ValErrorf[int]("Error b")), "Error b")
+}
+
+func TestFirstOk(t *testing.T) {
+	assert.EqualError(t, result.FirstOk[int](), "No values to check")
+	v := result.FirstOk(result.ValErrorf[int]("Error a"), result.NewVal(2), result.NewVal(3))
+	assert.Equal(t, 2, v.OrPanic("Unexpected error"))
+	assert.EqualError(
+		t,
+		result.FirstOk(result.ValErrorf[int]("Error a"), result.ValErrorf[int]("Error b")),
+		"Error a\nError b",
+	)
+}
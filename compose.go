@@ -0,0 +1,52 @@
+package result
+
+// Noop returns a step that wraps its argument in an ok Val unchanged. It's a placeholder for a pipeline step that's
+// conditionally left out, e.g:
+//     enrichStep := result.Noop[User]()
+//     if cfg.Enrich {
+//         enrichStep = enrich
+//     }
+func Noop[T any]() func(T) Val[T] {
+	return func(v T) Val[T] {
+		return NewVal(v)
+	}
+}
+
+// NoopStatus returns a step that always returns an ok Status
+func NoopStatus() func() Status {
+	return func() Status {
+		return Ok()
+	}
+}
+
+// Identity returns v unchanged
+func Identity[T any](v Val[T]) Val[T] {
+	return v
+}
+
+// ComposeAll returns a function that passes its argument through each of funcs in order, stopping at the first error
+// Val and returning it. With no funcs, the returned function wraps its argument in an ok Val unchanged. Usage:
+//     process := result.ComposeAll(normalize, validate, enrich)
+//     user := process(raw).
+//         OrError("Couldn't process user")
+func ComposeAll[T any](funcs ...func(T) Val[T]) func(T) Val[T] {
+	return func(v T) Val[T] {
+		return Waterfall(v, funcs...)
+	}
+}
+
+// Compose2 returns a function that passes its argument to f, then f's value to g, then g's value to h. It stops at the
+// first error Val and returns it. Unlike ComposeAll, each function can change the type of the value
+func Compose2[T, U, V, W any](f func(T) Val[U], g func(U) Val[V], h func(V) Val[W]) func(T) Val[W] {
+	return func(t T) Val[W] {
+		u := f(t)
+		if u.err != nil {
+			return ValError[W](u.err)
+		}
+		v := g(u.v)
+		if v.err != nil {
+			return ValError[W](v.err)
+		}
+		return h(v.v)
+	}
+}
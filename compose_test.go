@@ -0,0 +1,77 @@
+package result_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoop(t *testing.T) {
+	assert.Equal(t, result.NewVal("a"), result.Noop[string]()("a"))
+}
+
+func TestNoopStatus(t *testing.T) {
+	assert.Equal(t, result.Ok(), result.NoopStatus()())
+}
+
+func TestIdentity(t *testing.T) {
+	assert.Equal(t, result.NewVal(1), result.Identity(result.NewVal(1)))
+	v := result.ValErrorf[int]("Expected error")
+	assert.Equal(t, v, result.Identity(v))
+}
+
+func TestComposeAll(t *testing.T) {
+	calls := 0
+	assert.Equal(t, "x", result.ComposeAll[string]()("x").OrPanic("Unexpected error"))
+	assert.Equal(t, "xa", result.ComposeAll(waterfallStep("a", &calls))("x").OrPanic("Unexpected error"))
+	assert.Equal(
+		t,
+		"xab",
+		result.ComposeAll(waterfallStep("a", &calls), waterfallStep("b", &calls))("x").OrPanic("Unexpected error"),
+	)
+	calls = 0
+	assert.Equal(
+		t,
+		"xabc",
+		result.ComposeAll(waterfallStep("a", &calls), waterfallStep("b", &calls), waterfallStep("c", &calls))("x").
+			OrPanic("Unexpected error"),
+	)
+	assert.Equal(t, 3, calls)
+}
+
+func TestComposeAllErrors(t *testing.T) {
+	for i, expected := range []string{"Failed after x", "Failed after xa", "Failed after xab"} {
+		calls := 0
+		steps := []func(string) result.Val[string]{
+			waterfallStep("a", &calls),
+			waterfallStep("b", &calls),
+			waterfallStep("c", &calls),
+		}
+		steps[i] = waterfallStep("", &calls)
+		assert.EqualError(t, result.ComposeAll(steps...)("x"), expected)
+		assert.Equal(t, i+1, calls)
+	}
+}
+
+func TestCompose2(t *testing.T) {
+	f := result.Compose2(
+		func(s string) result.Val[int] {
+			return result.TryVal(strconv.Atoi(s))
+		},
+		func(i int) result.Val[float64] {
+			if i == 0 {
+				return result.ValErrorf[float64]("Can't divide by zero")
+			}
+			return result.NewVal(1 / float64(i))
+		},
+		func(f float64) result.Val[string] {
+			return result.NewVal(fmt.Sprint(f))
+		},
+	)
+	assert.Equal(t, "0.5", f("2").OrPanic("Unexpected error"))
+	assert.EqualError(t, f("x"), `strconv.Atoi: parsing "x": invalid syntax`)
+	assert.EqualError(t, f("0"), "Can't divide by zero")
+}
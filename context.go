@@ -0,0 +1,61 @@
+package result
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrHandleContext is like v.OrError(e), but first checks ctx. If ctx is done, it stops execution of the calling
+// function and returns an error wrapping ctx.Err(), whether or not v is ok. Otherwise it returns v's value, or stops
+// execution and returns an error if v is an error. It never blocks.
+//
+// OrHandleContext must only be used inside a function that returns an error or a result, and that has already defered
+// Handle or HandleError. Usage:
+//     func process(ctx context.Context) (res result.Status) {
+//         defer result.Handle(&res)
+//         for _, id := range ids {
+//             item := result.OrHandleContext(fetch(ctx, id), ctx, "Couldn't fetch item")
+//             save(item)
+//         }
+//         return result.Ok()
+//     }
+// If you use OrHandleContext without defering Handle or HandleError at the beginning of the function, it will panic
+func OrHandleContext[T any](v Val[T], ctx context.Context, e string) T {
+	if err := ctx.Err(); err != nil {
+		panic(panicToError{
+			err: fmt.Errorf("%v: %w", e, err),
+		})
+	}
+	return v.OrError(e)
+}
+
+// ValFromContext returns the value of ctx for key, as a T. If ctx has no value for key, or it isn't a T, it returns an
+// error Val. Usage:
+//     u := result.ValFromContext[User](ctx, userKey).
+//         OrError("Request isn't authenticated")
+func ValFromContext[T any](ctx context.Context, key any) Val[T] {
+	v := ctx.Value(key)
+	if v == nil {
+		return ValErrorf[T]("Context has no value for key %v", key)
+	}
+	t, ok := v.(T)
+	if !ok {
+		return ValErrorf[T]("Context value for key %v: expected %v, got %T", key, typeName[T](), v)
+	}
+	return NewVal(t)
+}
+
+// WithContextCancel calls f with a context derived from ctx, which is canceled as soon as f returns, so anything f
+// started with it is stopped. If ctx is already done, f isn't called, and an error wrapping ctx.Err() is returned.
+// Usage:
+//     prices := result.WithContextCancel(ctx, func(ctx context.Context) result.Val[Prices] {
+//         return result.Race(ctx, fetchFromPrimary, fetchFromReplica)
+//     })
+func WithContextCancel[T any](ctx context.Context, f func(context.Context) Val[T]) Val[T] {
+	if err := ctx.Err(); err != nil {
+		return ValErrorf[T]("Context was done before starting: %w", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	return f(ctx)
+}
@@ -0,0 +1,83 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func orHandleContext(ctx context.Context, v result.Val[int]) (res result.Val[int]) {
+	defer result.Handle(&res)
+	i := result.OrHandleContext(v, ctx, "Context")
+	return result.NewVal(i)
+}
+
+func TestOrHandleContextCancelledOk(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.EqualError(t, orHandleContext(ctx, result.NewVal(1)), "Context: context canceled")
+}
+
+func TestOrHandleContextCancelledError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.EqualError(
+		t,
+		orHandleContext(ctx, result.ValErrorf[int]("Expected error")),
+		"Context: context canceled",
+	)
+}
+
+func TestOrHandleContextActiveError(t *testing.T) {
+	assert.EqualError(
+		t,
+		orHandleContext(context.Background(), result.ValErrorf[int]("Expected error")),
+		"Context: Expected error",
+	)
+}
+
+func TestOrHandleContextActiveOk(t *testing.T) {
+	i := orHandleContext(context.Background(), result.NewVal(1)).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 1, i)
+}
+
+type ctxKey string
+
+func TestValFromContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey("user"), "a")
+	assert.Equal(t, "a", result.ValFromContext[string](ctx, ctxKey("user")).OrPanic("Unexpected error"))
+	assert.EqualError(
+		t,
+		result.ValFromContext[string](ctx, ctxKey("missing")),
+		"Context has no value for key missing",
+	)
+	assert.EqualError(
+		t,
+		result.ValFromContext[int](ctx, ctxKey("user")),
+		"Context value for key user: expected int, got string",
+	)
+}
+
+func TestWithContextCancel(t *testing.T) {
+	var child context.Context
+	v := result.WithContextCancel(context.Background(), func(ctx context.Context) result.Val[int] {
+		child = ctx
+		assert.Nil(t, ctx.Err())
+		return result.NewVal(1)
+	})
+	assert.Equal(t, 1, v.OrPanic("Unexpected error"))
+	assert.Equal(t, context.Canceled, child.Err())
+}
+
+func TestWithContextCancelDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	v := result.WithContextCancel(ctx, func(context.Context) result.Val[int] {
+		t.Error("f called with a done context")
+		return result.NewVal(1)
+	})
+	assert.EqualError(t, v, "Context was done before starting: context canceled")
+}
@@ -0,0 +1,93 @@
+package result
+
+import (
+	"reflect"
+)
+
+// EnsureType asserts that the value of v has type U. If v is ok and its value is a U, EnsureType returns an ok Val with
+// the value as a U. If v is ok but its value isn't a U (including a nil interface), it returns an error Val. If v is an
+// error, the error is passed through. Usage:
+//     f := result.EnsureType[io.Reader, *os.File](openReader(path)).
+//         OrError("Reader wasn't a file")
+func EnsureType[T, U any](v Val[T]) Val[U] {
+	if v.err != nil {
+		return ValError[U](v.err)
+	}
+	u, ok := any(v.v).(U)
+	if !ok {
+		return ValErrorf[U]("expected %v, got %T", typeName[U](), v.v)
+	}
+	return NewVal(u)
+}
+
+// FromInterface asserts that v has type T, like v.(T), but returns an error Val instead of panicking if it doesn't
+// (including if v is nil). T may be an interface type. Usage:
+//     name := result.FromInterface[string](claims["name"]).
+//         OrError("Name claim wasn't a string")
+func FromInterface[T any](v any) Val[T] {
+	return EnsureType[any, T](NewVal(v))
+}
+
+// EnsureTypePtr is like EnsureType for pointers. It asserts that the pointer in v is a *U, and returns an error Val if
+// it isn't or if it's nil
+func EnsureTypePtr[T, U any](v Val[*T]) Val[*U] {
+	if v.err != nil {
+		return ValError[*U](v.err)
+	}
+	if v.v == nil {
+		return ValErrorf[*U]("expected %v, got nil %T", typeName[*U](), v.v)
+	}
+	u, ok := any(v.v).(*U)
+	if !ok {
+		return ValErrorf[*U]("expected %v, got %T", typeName[*U](), v.v)
+	}
+	return NewVal(u)
+}
+
+// FromPointer returns an ok Val with the value p points to, or an error Val if p is nil. Usage:
+//     cfg := result.FromPointer(configs[name]).
+//         OrErrorf("No config named %v", name)
+func FromPointer[T any](p *T) Val[T] {
+	if p == nil {
+		return ValErrorf[T]("pointer was nil")
+	}
+	return NewVal(*p)
+}
+
+// FromNillable is like FromPointer for functions that return a pointer and an error. If err isn't nil, FromNillable
+// returns an error Val with it. Otherwise, it's the same as FromPointer(p). Usage:
+//     u := result.FromNillable(db.FindUser(id)).
+//         OrError("Couldn't find user")
+func FromNillable[T any](p *T, err error) Val[T] {
+	if err != nil {
+		return ValError[T](err)
+	}
+	return FromPointer(p)
+}
+
+// typeName returns the name of type T. Unlike formatting a zero T with %T, it works when T is an interface
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// Coerce converts the value of v with convert, which may fail. If v is an error, the error is passed through without
+// calling convert. If convert returns an error, Coerce returns an error Val with it. Usage:
+//     t := result.Coerce(readTimestamp(), func(s string) (time.Time, error) {
+//         return time.Parse(time.RFC3339, s)
+//     }).
+//         OrError("Couldn't read timestamp")
+func Coerce[From, To any](v Val[From], convert func(From) (To, error)) Val[To] {
+	if v.err != nil {
+		return ValError[To](v.err)
+	}
+	return TryVal(convert(v.v))
+}
+
+// CoerceStatus checks the value of v with convert, and returns an error Status if convert returns an error. If v is an
+// error, the error is passed through without calling convert
+func CoerceStatus[T any](v Val[T], convert func(T) error) Status {
+	if v.err != nil {
+		return Error(v.err)
+	}
+	return Try(convert(v.v))
+}
@@ -0,0 +1,136 @@
+package result_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureTypeOk(t *testing.T) {
+	b := result.EnsureType[io.Reader, *bytes.Buffer](result.NewVal[io.Reader](&bytes.Buffer{})).
+		OrPanic("Unexpected error")
+	assert.NotNil(t, b)
+}
+
+func TestEnsureTypeWrongType(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.EnsureType[io.Reader, *bytes.Buffer](result.NewVal[io.Reader](strings.NewReader(""))),
+		"expected *bytes.Buffer, got *strings.Reader",
+	)
+}
+
+func TestEnsureTypeInterface(t *testing.T) {
+	s := result.EnsureType[any, fmt.Stringer](result.NewVal[any](&strings.Builder{})).
+		OrPanic("Unexpected error")
+	assert.Equal(t, "", s.String())
+	assert.EqualError(
+		t,
+		result.EnsureType[any, fmt.Stringer](result.NewVal[any](1)),
+		"expected fmt.Stringer, got int",
+	)
+}
+
+func TestEnsureTypeNilInterface(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.EnsureType[io.Reader, *bytes.Buffer](result.NewVal[io.Reader](nil)),
+		"expected *bytes.Buffer, got <nil>",
+	)
+}
+
+func TestEnsureTypeError(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.EnsureType[io.Reader, *bytes.Buffer](result.ValErrorf[io.Reader]("Expected error")),
+		"Expected error",
+	)
+}
+
+func TestEnsureTypePtr(t *testing.T) {
+	b := result.EnsureTypePtr[bytes.Buffer, bytes.Buffer](result.NewVal(&bytes.Buffer{})).
+		OrPanic("Unexpected error")
+	assert.NotNil(t, b)
+	assert.EqualError(
+		t,
+		result.EnsureTypePtr[bytes.Buffer, strings.Builder](result.NewVal(&bytes.Buffer{})),
+		"expected *strings.Builder, got *bytes.Buffer",
+	)
+	assert.EqualError(
+		t,
+		result.EnsureTypePtr[bytes.Buffer, bytes.Buffer](result.NewVal[*bytes.Buffer](nil)),
+		"expected *bytes.Buffer, got nil *bytes.Buffer",
+	)
+}
+
+func TestCoerceInputError(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Coerce(result.ValErrorf[string]("Expected error"), func(s string) (int, error) {
+			t.Error("convert called on an error Val")
+			return 0, nil
+		}),
+		"Expected error",
+	)
+}
+
+func TestCoerceConversionError(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Coerce(result.NewVal("abc"), strconv.Atoi),
+		`strconv.Atoi: parsing "abc": invalid syntax`,
+	)
+}
+
+func TestCoerceOk(t *testing.T) {
+	i := result.Coerce(result.NewVal("12"), strconv.Atoi).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 12, i)
+}
+
+func TestCoerceStatus(t *testing.T) {
+	positive := func(i int) error {
+		if i <= 0 {
+			return fmt.Errorf("%v isn't positive", i)
+		}
+		return nil
+	}
+	assert.True(t, result.CoerceStatus(result.NewVal(1), positive).Ok())
+	assert.EqualError(t, result.CoerceStatus(result.NewVal(-1), positive), "-1 isn't positive")
+	assert.EqualError(t, result.CoerceStatus(result.ValErrorf[int]("Expected error"), positive), "Expected error")
+}
+
+func TestFromPointer(t *testing.T) {
+	i := 1
+	assert.Equal(t, 1, result.FromPointer(&i).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromPointer[int](nil), "pointer was nil")
+}
+
+func TestFromNillable(t *testing.T) {
+	i := 1
+	assert.Equal(t, 1, result.FromNillable(&i, nil).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromNillable[int](nil, nil), "pointer was nil")
+	assert.EqualError(t, result.FromNillable(&i, io.EOF), "EOF")
+}
+
+func TestFromInterface(t *testing.T) {
+	assert.Equal(t, "a", result.FromInterface[string]("a").OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromInterface[string](1), "expected string, got int")
+	assert.EqualError(t, result.FromInterface[io.Reader](1), "expected io.Reader, got int")
+	assert.EqualError(t, result.FromInterface[string](nil), "expected string, got <nil>")
+	assert.NotNil(t, result.FromInterface[io.Reader](&bytes.Buffer{}).OrPanic("Unexpected error"))
+}
+
+func TestFromInterfaceTypedNil(t *testing.T) {
+	var b *bytes.Buffer
+	v := result.FromInterface[*bytes.Buffer](b)
+	assert.True(t, v.Ok())
+	assert.Nil(t, v.OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromInterface[*strings.Builder](b), "expected *strings.Builder, got *bytes.Buffer")
+}
@@ -0,0 +1,83 @@
+package result
+
+import (
+	"fmt"
+	"io"
+)
+
+// Cursor reads items one at a time from a paginated source, fetching a new page each time the current one runs out
+type Cursor[T any] struct {
+	fetch     func(offset, limit int) Val[[]T]
+	limit     int
+	offset    int
+	page      []T
+	exhausted bool
+	closed    bool
+}
+
+// NewCursor returns a new Cursor that fetches pages of up to limit items with fetch. A page with fewer than limit items
+// is taken to be the last one. Usage:
+//     c := result.NewCursor(listUsers, 100)
+//     defer c.Close()
+//     for c.HasMore() {
+//         u := c.Next().
+//             OrError("Couldn't get next user")
+//         // ...
+//     }
+func NewCursor[T any](fetch func(offset, limit int) Val[[]T], limit int) *Cursor[T] {
+	return &Cursor[T]{
+		fetch: fetch,
+		limit: limit,
+	}
+}
+
+// Next returns the next item, fetching a new page if needed. It returns an error Val if fetching fails, if the cursor
+// is closed, or one wrapping io.EOF if there are no more items. A failed fetch can be retried by calling Next again
+func (c *Cursor[T]) Next() Val[T] {
+	if c.closed {
+		return ValErrorf[T]("Cursor is closed")
+	}
+	if c.limit <= 0 {
+		return ValErrorf[T]("Cursor limit must be positive, got %v", c.limit)
+	}
+	if len(c.page) == 0 && !c.exhausted {
+		page := c.fetch(c.offset, c.limit)
+		if page.err != nil {
+			return ValError[T](page.err)
+		}
+		c.page = page.v
+		c.offset += len(page.v)
+		c.exhausted = len(page.v) < c.limit
+	}
+	if len(c.page) == 0 {
+		return ValError[T](fmt.Errorf("Cursor has no more items: %w", io.EOF))
+	}
+	v := c.page[0]
+	c.page = c.page[1:]
+	return NewVal(v)
+}
+
+// HasMore returns whether more items may be available. It's false once the last page has been fetched and all of its
+// items returned. If the last page is exactly full, HasMore can't know it's the last, and returns true until Next
+// fetches an empty page
+func (c *Cursor[T]) HasMore() bool {
+	return !c.closed && (len(c.page) > 0 || !c.exhausted)
+}
+
+// Close releases the cursor's current page. Next can't be called after Close
+func (c *Cursor[T]) Close() Status {
+	c.closed = true
+	c.page = nil
+	return Ok()
+}
+
+// Rewind moves the cursor back to the beginning, so the next call to Next fetches the first page again
+func (c *Cursor[T]) Rewind() Status {
+	if c.closed {
+		return Errorf("Cursor is closed")
+	}
+	c.offset = 0
+	c.page = nil
+	c.exhausted = false
+	return Ok()
+}
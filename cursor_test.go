@@ -0,0 +1,81 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// cursorFetch returns a fetch func over 0 to n-1, counting its fetches in *fetches
+func cursorFetch(n int, fetches *int) func(offset, limit int) result.Val[[]int] {
+	return func(offset, limit int) result.Val[[]int] {
+		*fetches++
+		page := []int{}
+		for i := offset; i < offset+limit && i < n; i++ {
+			page = append(page, i)
+		}
+		return result.NewVal(page)
+	}
+}
+
+func cursorDrain(c *result.Cursor[int]) []int {
+	items := []int{}
+	for c.HasMore() {
+		v := c.Next()
+		if !v.Ok() {
+			break
+		}
+		items = append(items, v.OrPanic("Unexpected error"))
+	}
+	return items
+}
+
+func TestCursor(t *testing.T) {
+	fetches := 0
+	c := result.NewCursor(cursorFetch(5, &fetches), 2)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, cursorDrain(c))
+	assert.Equal(t, 3, fetches)
+	assert.False(t, c.HasMore())
+	assert.EqualError(t, c.Next(), "Cursor has no more items: EOF")
+}
+
+func TestCursorExactlyFullLastPage(t *testing.T) {
+	fetches := 0
+	c := result.NewCursor(cursorFetch(4, &fetches), 2)
+	assert.Equal(t, []int{0, 1, 2, 3}, cursorDrain(c))
+	assert.Equal(t, 3, fetches)
+	assert.False(t, c.HasMore())
+}
+
+func TestCursorRewind(t *testing.T) {
+	fetches := 0
+	c := result.NewCursor(cursorFetch(3, &fetches), 2)
+	assert.Equal(t, 0, c.Next().OrPanic("Unexpected error"))
+	assert.True(t, c.Rewind().Ok())
+	assert.Equal(t, []int{0, 1, 2}, cursorDrain(c))
+	assert.Equal(t, 3, fetches)
+}
+
+func TestCursorFetchError(t *testing.T) {
+	fail := true
+	c := result.NewCursor(func(offset, limit int) result.Val[[]int] {
+		if fail {
+			return result.ValErrorf[[]int]("Expected error")
+		}
+		return result.NewVal([]int{7})
+	}, 2)
+	assert.EqualError(t, c.Next(), "Expected error")
+	fail = false
+	assert.Equal(t, 7, c.Next().OrPanic("Unexpected error"))
+}
+
+func TestCursorClose(t *testing.T) {
+	fetches := 0
+	c := result.NewCursor(cursorFetch(3, &fetches), 2)
+	assert.True(t, c.Close().Ok())
+	assert.False(t, c.HasMore())
+	assert.EqualError(t, c.Next(), "Cursor is closed")
+	assert.EqualError(t, c.Rewind(), "Cursor is closed")
+	assert.Equal(t, 0, fetches)
+}
@@ -0,0 +1,30 @@
+package result
+
+import (
+	"os"
+)
+
+// FromEnv returns the value of the environment variable key, which may be empty, as an ok Val. If it isn't set,
+// FromEnv returns an error Val. Usage:
+//     home := result.FromEnv("HOME").
+//         OrError("Couldn't find home directory")
+func FromEnv(key string) Val[string] {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return ValErrorf[string]("Environment variable %v isn't set", key)
+	}
+	return NewVal(v)
+}
+
+// FromEnvAs is like FromEnv, but also parses the value with parse. Usage:
+//     port := result.FromEnvAs("PORT", strconv.Atoi).
+//         OrError("Couldn't get port")
+func FromEnvAs[T any](key string, parse func(string) (T, error)) Val[T] {
+	return Chain(FromEnv(key), func(s string) Val[T] {
+		v, err := parse(s)
+		if err != nil {
+			return ValErrorf[T]("Couldn't parse environment variable %v: %w", key, err)
+		}
+		return NewVal(v)
+	})
+}
@@ -0,0 +1,33 @@
+package result_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("RESULT_TEST_NAME", "a")
+	t.Setenv("RESULT_TEST_EMPTY", "")
+	assert.Equal(t, "a", result.FromEnv("RESULT_TEST_NAME").OrPanic("Unexpected error"))
+	assert.Equal(t, "", result.FromEnv("RESULT_TEST_EMPTY").OrPanic("Unexpected error"))
+	assert.EqualError(t, result.FromEnv("RESULT_TEST_UNSET"), "Environment variable RESULT_TEST_UNSET isn't set")
+}
+
+func TestFromEnvAs(t *testing.T) {
+	t.Setenv("RESULT_TEST_PORT", "8080")
+	t.Setenv("RESULT_TEST_BAD_PORT", "x")
+	assert.Equal(t, 8080, result.FromEnvAs("RESULT_TEST_PORT", strconv.Atoi).OrPanic("Unexpected error"))
+	assert.EqualError(
+		t,
+		result.FromEnvAs("RESULT_TEST_BAD_PORT", strconv.Atoi),
+		`Couldn't parse environment variable RESULT_TEST_BAD_PORT: strconv.Atoi: parsing "x": invalid syntax`,
+	)
+	assert.EqualError(
+		t,
+		result.FromEnvAs("RESULT_TEST_UNSET", strconv.Atoi),
+		"Environment variable RESULT_TEST_UNSET isn't set",
+	)
+}
@@ -1,5 +1,9 @@
 package result
 
+import (
+	"fmt"
+)
+
 type panicToReturn struct {
 	err error
 }
@@ -15,3 +19,16 @@ type panicToError struct {
 func (p panicToError) Error() string {
 	return "Unrecovered panic from result. Use `defer result.Handle(&r)` or `defer result.HandleError(&err)` at the top of the func to convert the panic into a returned result or error: " + p.err.Error()
 }
+
+// panicError converts r, a value from recover, into an error. Errors from OrError keep their original error
+func panicError(r any) error {
+	switch p := r.(type) {
+	case panicToError:
+		return p.err
+	case panicToReturn:
+		return p.err
+	case error:
+		return fmt.Errorf("panic: %w", p)
+	}
+	return fmt.Errorf("panic: %v", r)
+}
@@ -0,0 +1,31 @@
+package result
+
+import (
+	"os"
+)
+
+// FromFile reads the file at path with os.ReadFile, and returns its contents. If reading fails, it returns an error Val
+// with the *fs.PathError from os.ReadFile, which includes path. Usage:
+//     data := result.FromFile("config.yaml").
+//         OrError("Couldn't load config")
+func FromFile(path string) Val[[]byte] {
+	return TryVal(os.ReadFile(path))
+}
+
+// FromFileAs is like FromFile, but also decodes the file's contents with decode. If decoding fails, the error includes
+// path. Usage:
+//     cfg := result.FromFileAs("config.json", func(b []byte) (Config, error) {
+//         var c Config
+//         err := json.Unmarshal(b, &c)
+//         return c, err
+//     }).
+//         OrError("Couldn't load config")
+func FromFileAs[T any](path string, decode func([]byte) (T, error)) Val[T] {
+	return Chain(FromFile(path), func(b []byte) Val[T] {
+		v, err := decode(b)
+		if err != nil {
+			return ValErrorf[T]("Couldn't decode %v: %w", path, err)
+		}
+		return NewVal(v)
+	})
+}
@@ -0,0 +1,47 @@
+package result_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTemp(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	err := os.WriteFile(path, []byte(contents), 0o600)
+	assert.Nil(t, err)
+	return path
+}
+
+func TestFromFile(t *testing.T) {
+	path := writeTemp(t, "hello")
+	assert.Equal(t, []byte("hello"), result.FromFile(path).OrPanic("Unexpected error"))
+
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+	v := result.FromFile(missing)
+	assert.True(t, errors.Is(v, fs.ErrNotExist))
+	assert.Contains(t, v.Error(), missing)
+}
+
+func TestFromFileAs(t *testing.T) {
+	atoi := func(b []byte) (int, error) {
+		return strconv.Atoi(strings.TrimSpace(string(b)))
+	}
+	path := writeTemp(t, "12\n")
+	assert.Equal(t, 12, result.FromFileAs(path, atoi).OrPanic("Unexpected error"))
+
+	path = writeTemp(t, "x")
+	assert.EqualError(
+		t,
+		result.FromFileAs(path, atoi),
+		"Couldn't decode "+path+`: strconv.Atoi: parsing "x": invalid syntax`,
+	)
+	assert.True(t, errors.Is(result.FromFileAs(filepath.Join(t.TempDir(), "missing.txt"), atoi), fs.ErrNotExist))
+}
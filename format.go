@@ -0,0 +1,183 @@
+package result
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format implements fmt.Formatter. An ok Val is formatted as its value, using the same verb and flags. An error Val is
+// formatted as its error message, like any other error, so it can still be wrapped with %w. %+v adds the type and marks
+// errors, e.g. Val[int](1) or Val[int](Err(Couldn't parse)), and %#v is the same as GoString. Usage:
+//     log.Printf("Got %v", v)  // "Got 1" or "Got Couldn't parse"
+//     log.Printf("Got %+v", v) // "Got Val[int](1)" or "Got Val[int](Err(Couldn't parse))"
+func (v Val[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, v.GoString())
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "Val[%v](%v)", typeName[T](), formatValue(v.err, "%+v", v.v))
+	case v.err != nil:
+		formatError(f, verb, v.err)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), v.v)
+	}
+}
+
+// GoString returns Go syntax that would create the Val, e.g. result.NewVal[int](1), or
+// result.ValError[int](errors.New("Couldn't parse"))
+func (v Val[T]) GoString() string {
+	if v.err != nil {
+		return fmt.Sprintf("result.ValError[%v](errors.New(%q))", typeName[T](), v.err.Error())
+	}
+	return fmt.Sprintf("result.NewVal[%v](%#v)", typeName[T](), v.v)
+}
+
+// Format implements fmt.Formatter. A Status is formatted as its error message, like any other error, which is empty if
+// it's ok. %+v formats it as Status(Ok) or Status(Err(message)), and %#v is the same as GoString
+func (s Status) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, s.GoString())
+	case verb == 'v' && f.Flag('+'):
+		str := "Ok"
+		if s.err != nil {
+			str = formatErr(s.err)
+		}
+		fmt.Fprintf(f, "Status(%v)", str)
+	default:
+		formatError(f, verb, s)
+	}
+}
+
+// GoString returns Go syntax that would create the Status, e.g. result.Ok(), or result.Error(errors.New("Failed"))
+func (s Status) GoString() string {
+	if s.err != nil {
+		return fmt.Sprintf("result.Error(errors.New(%q))", s.err.Error())
+	}
+	return "result.Ok()"
+}
+
+// Format implements fmt.Formatter. An ok Vals is formatted as its values in parentheses, e.g. (a, 1), using the same
+// verb and flags for each. An error Vals is formatted as its error message, like any other error. %+v adds the types
+// and marks errors, e.g. Vals[string, int](a, 1) or Vals[string, int](Err(Failed)), and %#v is the same as GoString
+func (v Vals[T, U]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, v.GoString())
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "Vals[%v, %v]%v", typeName[T](), typeName[U](), formatValues(v.err, "%+v", v.v0, v.v1))
+	case v.err != nil:
+		formatError(f, verb, v.err)
+	default:
+		fmt.Fprint(f, formatValues(nil, fmt.FormatString(f, verb), v.v0, v.v1))
+	}
+}
+
+// GoString returns Go syntax that would create the Vals, e.g. result.NewVals[string, int]("a", 1), or
+// result.ValsError[string, int](errors.New("Failed"))
+func (v Vals[T, U]) GoString() string {
+	if v.err != nil {
+		return fmt.Sprintf("result.ValsError[%v, %v](errors.New(%q))", typeName[T](), typeName[U](), v.err.Error())
+	}
+	return fmt.Sprintf("result.NewVals[%v, %v](%#v, %#v)", typeName[T](), typeName[U](), v.v0, v.v1)
+}
+
+// Format is the same as Vals.Format, for three values, e.g. (a, 1, true)
+func (v Vals3[T, U, V]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, v.GoString())
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(
+			f,
+			"Vals3[%v, %v, %v]%v",
+			typeName[T](),
+			typeName[U](),
+			typeName[V](),
+			formatValues(v.err, "%+v", v.v0, v.v1, v.v2),
+		)
+	case v.err != nil:
+		formatError(f, verb, v.err)
+	default:
+		fmt.Fprint(f, formatValues(nil, fmt.FormatString(f, verb), v.v0, v.v1, v.v2))
+	}
+}
+
+// GoString returns Go syntax that would create the Vals3, e.g. result.NewVals3[string, int, bool]("a", 1, true), or
+// result.ValsError3[string, int, bool](errors.New("Failed"))
+func (v Vals3[T, U, V]) GoString() string {
+	types := fmt.Sprintf("%v, %v, %v", typeName[T](), typeName[U](), typeName[V]())
+	if v.err != nil {
+		return fmt.Sprintf("result.ValsError3[%v](errors.New(%q))", types, v.err.Error())
+	}
+	return fmt.Sprintf("result.NewVals3[%v](%#v, %#v, %#v)", types, v.v0, v.v1, v.v2)
+}
+
+// Format is the same as Vals.Format, for four values, e.g. (a, 1, true, 2.5)
+func (v Vals4[T, U, V, W]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, v.GoString())
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(
+			f,
+			"Vals4[%v, %v, %v, %v]%v",
+			typeName[T](),
+			typeName[U](),
+			typeName[V](),
+			typeName[W](),
+			formatValues(v.err, "%+v", v.v0, v.v1, v.v2, v.v3),
+		)
+	case v.err != nil:
+		formatError(f, verb, v.err)
+	default:
+		fmt.Fprint(f, formatValues(nil, fmt.FormatString(f, verb), v.v0, v.v1, v.v2, v.v3))
+	}
+}
+
+// GoString returns Go syntax that would create the Vals4, e.g. result.NewVals4[string, int, bool, float64]("a", 1,
+// true, 2.5), or result.Vals4Error[string, int, bool, float64](errors.New("Failed"))
+func (v Vals4[T, U, V, W]) GoString() string {
+	types := fmt.Sprintf("%v, %v, %v, %v", typeName[T](), typeName[U](), typeName[V](), typeName[W]())
+	if v.err != nil {
+		return fmt.Sprintf("result.Vals4Error[%v](errors.New(%q))", types, v.err.Error())
+	}
+	return fmt.Sprintf("result.NewVals4[%v](%#v, %#v, %#v, %#v)", types, v.v0, v.v1, v.v2, v.v3)
+}
+
+// formatValues formats values with format, separated by commas and in parentheses. If err isn't nil, it returns
+// Err(message) in parentheses instead
+func formatValues(err error, format string, values ...any) string {
+	if err != nil {
+		return "(" + formatErr(err) + ")"
+	}
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = fmt.Sprintf(format, value)
+	}
+	return "(" + strings.Join(strs, ", ") + ")"
+}
+
+// formatValue formats value with format, or returns Err(message) if err isn't nil
+func formatValue(err error, format string, value any) string {
+	if err != nil {
+		return formatErr(err)
+	}
+	return fmt.Sprintf(format, value)
+}
+
+// formatErr formats err as Err(message)
+func formatErr(err error) string {
+	return fmt.Sprintf("Err(%v)", err)
+}
+
+// formatError formats err's message the way fmt formats an error without a Format method: with the verb and flags for
+// %v, %s and %q, and as is for other verbs
+func formatError(f fmt.State, verb rune, err error) {
+	switch verb {
+	case 'v', 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), err.Error())
+	default:
+		fmt.Fprint(f, err.Error())
+	}
+}
@@ -0,0 +1,84 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValFormat(t *testing.T) {
+	assert.Equal(t, "1", fmt.Sprintf("%v", result.NewVal(1)))
+	assert.Equal(t, "0x0a", fmt.Sprintf("%#02x", result.NewVal(10)))
+	assert.Equal(t, "Expected error", fmt.Sprintf("%v", result.ValErrorf[int]("Expected error")))
+	assert.Equal(t, `"Expected error"`, fmt.Sprintf("%q", result.ValErrorf[int]("Expected error")))
+	assert.Equal(t, "Val[int](1)", fmt.Sprintf("%+v", result.NewVal(1)))
+	assert.Equal(t, "Val[int](Err(Expected error))", fmt.Sprintf("%+v", result.ValErrorf[int]("Expected error")))
+}
+
+func TestValGoString(t *testing.T) {
+	assert.Equal(t, `result.NewVal[string]("a")`, fmt.Sprintf("%#v", result.NewVal("a")))
+	assert.Equal(
+		t,
+		`result.ValError[int](errors.New("Expected error"))`,
+		result.ValErrorf[int]("Expected error").GoString(),
+	)
+}
+
+func TestStatusFormat(t *testing.T) {
+	assert.Equal(t, "", fmt.Sprintf("%v", result.Ok()))
+	assert.Equal(t, "Expected error", fmt.Sprint(result.Errorf("Expected error")))
+	assert.Equal(t, "Status(Ok)", fmt.Sprintf("%+v", result.Ok()))
+	assert.Equal(t, "Status(Err(Expected error))", fmt.Sprintf("%+v", result.Errorf("Expected error")))
+	assert.Equal(t, "result.Ok()", fmt.Sprintf("%#v", result.Ok()))
+	assert.Equal(t, `result.Error(errors.New("Expected error"))`, result.Errorf("Expected error").GoString())
+}
+
+func TestValsFormat(t *testing.T) {
+	v := result.NewVals("a", 1)
+	assert.Equal(t, "(a, 1)", fmt.Sprintf("%v", v))
+	assert.Equal(t, `("a", "b")`, fmt.Sprintf("%q", result.NewVals("a", "b")))
+	assert.Equal(t, "Vals[string, int](a, 1)", fmt.Sprintf("%+v", v))
+	assert.Equal(t, `result.NewVals[string, int]("a", 1)`, fmt.Sprintf("%#v", v))
+	e := result.ValsErrorf[string, int]("Expected error")
+	assert.Equal(t, "Expected error", fmt.Sprintf("%v", e))
+	assert.Equal(t, "Vals[string, int](Err(Expected error))", fmt.Sprintf("%+v", e))
+	assert.Equal(t, `result.ValsError[string, int](errors.New("Expected error"))`, e.GoString())
+}
+
+func TestVals3Format(t *testing.T) {
+	v := result.NewVals3("a", 1, true)
+	assert.Equal(t, "(a, 1, true)", fmt.Sprint(v))
+	assert.Equal(t, "Vals3[string, int, bool](a, 1, true)", fmt.Sprintf("%+v", v))
+	assert.Equal(t, `result.NewVals3[string, int, bool]("a", 1, true)`, fmt.Sprintf("%#v", v))
+	e := result.ValsErrorf3[string, int, bool]("Expected error")
+	assert.Equal(t, "Expected error", fmt.Sprintf("%v", e))
+	assert.Equal(t, "Vals3[string, int, bool](Err(Expected error))", fmt.Sprintf("%+v", e))
+	assert.Equal(t, `result.ValsError3[string, int, bool](errors.New("Expected error"))`, e.GoString())
+}
+
+func TestVals4Format(t *testing.T) {
+	v := result.NewVals4("a", 1, true, 2.5)
+	assert.Equal(t, "(a, 1, true, 2.5)", fmt.Sprint(v))
+	assert.Equal(t, "Vals4[string, int, bool, float64](a, 1, true, 2.5)", fmt.Sprintf("%+v", v))
+	assert.Equal(t, `result.NewVals4[string, int, bool, float64]("a", 1, true, 2.5)`, fmt.Sprintf("%#v", v))
+	e := result.Vals4Errorf[string, int, bool, float64]("Expected error")
+	assert.Equal(t, "Expected error", fmt.Sprintf("%v", e))
+	assert.Equal(t, "Vals4[string, int, bool, float64](Err(Expected error))", fmt.Sprintf("%+v", e))
+	assert.Equal(t, `result.Vals4Error[string, int, bool, float64](errors.New("Expected error"))`, e.GoString())
+}
+
+func TestFormatWrapped(t *testing.T) {
+	var err error = result.Error(io.EOF)
+	wrapped := fmt.Errorf("Context: %w", err)
+	assert.EqualError(t, wrapped, "Context: EOF")
+	assert.True(t, errors.Is(wrapped, io.EOF))
+	assert.Equal(t, "EOF", fmt.Sprint(err))
+	assert.EqualError(t, fmt.Errorf("Context: %w", result.ValError[int](io.EOF)), "Context: EOF")
+	assert.EqualError(t, fmt.Errorf("Context: %w", result.ValsError[int, int](io.EOF)), "Context: EOF")
+	assert.EqualError(t, fmt.Errorf("Context: %w", result.ValsError3[int, int, int](io.EOF)), "Context: EOF")
+	assert.EqualError(t, fmt.Errorf("Context: %w", result.Vals4Error[int, int, int, int](io.EOF)), "Context: EOF")
+}
@@ -1,5 +1,9 @@
 package result
 
+import (
+	"runtime/debug"
+)
+
 // HandleReturn must be defered at the beginning of a function if that function doesn't return an error or a result, in
 // order to use OrDoAndReturn within the function. Usage:
 //     func main() {
@@ -54,6 +58,51 @@ func HandleError(err *error) {
 	panic(r)
 }
 
+// HandlePanic is like HandleError, but also recovers from panics that didn't come from a result, and sets *err to an
+// error describing the panic instead of passing it through. Use it where no panic should escape, e.g. at the top of a
+// goroutine or a request handler. Usage:
+//     func serve(req Request) (err error) {
+//         defer result.HandlePanic(&err)
+//         // any panic here is returned as an error
+//     }
+func HandlePanic(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(panicToReturn); ok {
+		return
+	}
+	*err = panicError(r)
+}
+
+// HandleCollect is like HandleError, but appends the error to *errs instead of replacing an error. Go can't resume a
+// function after it panics, so the function HandleCollect is deferred in still stops at the first error. To keep going
+// after each error, defer HandleCollect in a function for each step, e.g:
+//     var errs []error
+//     for _, id := range ids {
+//         func() {
+//             defer result.HandleCollect(&errs)
+//             process(id).
+//                 OrErrorf("Couldn't process %v", id)
+//         }()
+//     }
+//     return errors.Join(errs...)
+func HandleCollect(errs *[]error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(panicToReturn); ok {
+		return
+	}
+	if p, ok := r.(panicToError); ok {
+		*errs = append(*errs, p.err)
+		return
+	}
+	panic(r)
+}
+
 // Handle must be defered at the begining of a function if that function returns a result, in order to to use
 // OrError or OrDoAndReturn within the function. res must be a pointer to the named result return value of the
 // function. Usage:
@@ -67,7 +116,151 @@ func HandleError(err *error) {
 // If you use OrError or OrDoAndReturn without defering Handle, HandleError, or HandleReturn at the beginning of the
 // function, it will panic
 func Handle(res errorSetter) {
+	handle(recover(), res)
+}
+
+// HandleWithCallback is like Handle, but also calls onErr with the error when it stops the function with an error from
+// OrError. onErr isn't called if the function returns normally, even if it returns an error result. Usage:
+//     func f() (res result.Status) {
+//         defer result.HandleWithCallback(&res, func(err error) {
+//             errorCount.Inc()
+//         })
+//         // ...
+//     }
+func HandleWithCallback(res errorSetter, onErr func(error)) {
+	r := recover()
+	if p, ok := r.(panicToError); ok {
+		onErr(p.err)
+	}
+	handle(r, res)
+}
+
+// HandleStack is like Handle, but wraps the error from OrError in a StackError with the stack trace of the goroutine
+// where OrError was called. Usage:
+//     func f() (res result.Status) {
+//         defer result.HandleStack(&res)
+//         // ...
+//     }
+func HandleStack(res errorSetter) {
+	r := recover()
+	if p, ok := r.(panicToError); ok {
+		// While a deferred function runs during a panic, the frames that panicked are still on the stack, so this
+		// includes the OrError call, without the cost of capturing a stack on every call to OrError
+		r = panicToError{
+			err: &StackError{
+				err:   p.err,
+				stack: debug.Stack(),
+			},
+		}
+	}
+	handle(r, res)
+}
+
+// StackError is an error with the stack trace of where it happened. It's made by HandleStack
+type StackError struct {
+	err   error
+	stack []byte
+}
+
+// Error returns the message of the underlying error, without the stack trace
+func (s *StackError) Error() string {
+	return s.err.Error()
+}
+
+// Unwrap returns the underlying error
+func (s *StackError) Unwrap() error {
+	return s.err
+}
+
+// Stack returns the stack trace, formatted like debug.Stack
+func (s *StackError) Stack() []byte {
+	return s.stack
+}
+
+// HandleWithRecover is like Handle, but also recovers from panics that didn't come from a result, using convertPanic
+// to convert each panic value into an error for res. If convertPanic returns nil, the panic is passed through, like
+// Handle does. Usage:
+//     func f() (res result.Status) {
+//         defer result.HandleWithRecover(&res, func(r any) error {
+//             if e, ok := r.(legacyError); ok {
+//                 return e.toError()
+//             }
+//             return nil
+//         })
+//         // ...
+//     }
+func HandleWithRecover(res errorSetter, convertPanic func(any) error) {
 	r := recover()
+	switch r.(type) {
+	case nil, panicToError, panicToReturn:
+		handle(r, res)
+		return
+	}
+	err := convertPanic(r)
+	if err == nil {
+		panic(r)
+	}
+	res.setError(err)
+}
+
+// HandleChain runs handlers, in the order they'd run if each were defered in the order given: the last handler runs, and
+// gets any panic, first. Like defered functions, they run whether or not the function panicked. A panic a handler
+// passes on, or starts, goes to the handler before it, and a panic that no handler recovers continues past the
+// function. Usage:
+//     func f() {
+//         defer result.HandleChain(logPanics, result.HandleReturn)
+//         // ...
+//     }
+// Go only lets recover stop a panic when it's called directly by a defered function, so each handler must call recover
+// itself, like HandleReturn does. A closure that calls Handle or HandleError won't work as a handler
+func HandleChain(handlers ...func()) {
+	runHandlers(recover(), handlers)
+}
+
+// runHandlers defers each of handlers. If r, a value from recover, isn't nil, it then panics with r so they can
+// recover it
+func runHandlers(r any, handlers []func()) {
+	for _, h := range handlers {
+		defer h()
+	}
+	if r != nil {
+		panic(r)
+	}
+}
+
+// Handle2 is like Handle, for functions that return two named results. If the function stops with an error, the error
+// is set on both r1 and r2. Usage:
+//     func f() (a result.Val[int], b result.Val[string]) {
+//         defer result.Handle2(&a, &b)
+//         // ...
+//     }
+func Handle2[T, U any](r1 *Val[T], r2 *Val[U]) {
+	handle(recover(), r1, r2)
+}
+
+// Handle2Status is like Handle2, for functions that return two named Statuses
+func Handle2Status(s1, s2 *Status) {
+	handle(recover(), s1, s2)
+}
+
+// DeferHandle calls f with Handle defered, so f can use OrError without being a named function with its own handler.
+// f's result, or the error that stopped it, is stored in *res and returned. Usage:
+//     total := result.DeferHandle(new(result.Val[int]), func() result.Val[int] {
+//         a := calcA().OrError("Couldn't calculate a")
+//         b := calcB().OrError("Couldn't calculate b")
+//         return result.NewVal(a + b)
+//     })
+func DeferHandle[T any](res *Val[T], f func() Val[T]) Val[T] {
+	func() {
+		defer Handle(res)
+		*res = f()
+	}()
+	return *res
+}
+
+// handle converts r, a value from recover, into a return. If r came from OrError, its error is set on each of res.
+// Panics that didn't come from a result are passed through
+func handle(r any, res ...errorSetter) {
 	if r == nil {
 		return
 	}
@@ -77,7 +270,9 @@ func Handle(res errorSetter) {
 	}
 	p, ok := r.(panicToError)
 	if ok {
-		res.setError(p.err)
+		for _, s := range res {
+			s.setError(p.err)
+		}
 		return
 	}
 	panic(r)
@@ -1,6 +1,9 @@
 package result_test
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/bmheenan/result"
@@ -74,3 +77,258 @@ func TestPanicsThroughHandleReturn(t *testing.T) {
 		panic("Expected panic")
 	})
 }
+
+func handle2(fail bool) (a result.Val[int], b result.Val[string]) {
+	defer result.Handle2(&a, &b)
+	if fail {
+		result.Errorf("Expected error").
+			OrError("Context")
+	}
+	return result.NewVal(1), result.NewVal("b")
+}
+
+func TestHandle2(t *testing.T) {
+	a, b := handle2(true)
+	assert.EqualError(t, a, "Context: Expected error")
+	assert.EqualError(t, b, "Context: Expected error")
+
+	a, b = handle2(false)
+	assert.Equal(t, 1, a.OrPanic("Unexpected error"))
+	assert.Equal(t, "b", b.OrPanic("Unexpected error"))
+}
+
+func handle2Status(fail bool) (a, b result.Status) {
+	defer result.Handle2Status(&a, &b)
+	if fail {
+		result.Errorf("Expected error").
+			OrError("Context")
+	}
+	return result.Ok(), result.Errorf("Constructed error")
+}
+
+func TestHandle2Status(t *testing.T) {
+	a, b := handle2Status(true)
+	assert.EqualError(t, a, "Context: Expected error")
+	assert.EqualError(t, b, "Context: Expected error")
+
+	a, b = handle2Status(false)
+	assert.True(t, a.Ok())
+	assert.EqualError(t, b, "Constructed error")
+}
+
+func wrapHandle() (res result.Val[int]) {
+	defer result.Handle(&res)
+	result.ValErrorf[int]("loading config: %w", io.ErrUnexpectedEOF).
+		OrError("Context")
+	return result.NewVal(0)
+}
+
+func wrapHandleError() (err error) {
+	defer result.HandleError(&err)
+	result.Errorf("loading config: %w", io.ErrUnexpectedEOF).
+		OrError("Context")
+	return nil
+}
+
+func TestErrorfWrapping(t *testing.T) {
+	v := result.ValErrorf[int]("loading config: %w", io.ErrUnexpectedEOF)
+	assert.True(t, errors.Is(v.Err(), io.ErrUnexpectedEOF))
+
+	res := wrapHandle()
+	assert.EqualError(t, res, "Context: loading config: unexpected EOF")
+	assert.True(t, errors.Is(res.Err(), io.ErrUnexpectedEOF))
+
+	err := wrapHandleError()
+	assert.EqualError(t, err, "Context: loading config: unexpected EOF")
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+}
+
+func TestOrPanicWrapping(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		assert.True(t, ok)
+		assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	}()
+	result.ValsErrorf[int, int]("loading config: %w", io.ErrUnexpectedEOF).
+		OrPanic("Context")
+}
+
+func handlePanic(f func()) (err error) {
+	defer result.HandlePanic(&err)
+	f()
+	return nil
+}
+
+func TestHandlePanic(t *testing.T) {
+	assert.Nil(t, handlePanic(func() {}))
+	assert.EqualError(t, handlePanic(func() {
+		panic("Expected panic")
+	}), "panic: Expected panic")
+	assert.EqualError(t, handlePanic(func() {
+		result.Errorf("Expected error").OrError("Context")
+	}), "Context: Expected error")
+	assert.Nil(t, handlePanic(func() {
+		result.Errorf("Expected error").OrDoAndReturn(func(error) {})
+	}))
+	err := handlePanic(func() {
+		panic(io.EOF)
+	})
+	assert.EqualError(t, err, "panic: EOF")
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestHandleCollect(t *testing.T) {
+	var errs []error
+	ran := []int{}
+	for i := 0; i < 4; i++ {
+		func() {
+			defer result.HandleCollect(&errs)
+			if i%2 == 1 {
+				result.Errorf("Expected error").OrErrorf("Step %v", i)
+			}
+			ran = append(ran, i)
+		}()
+	}
+	assert.Equal(t, []int{0, 2}, ran)
+	assert.EqualError(t, errors.Join(errs...), "Step 1: Expected error\nStep 3: Expected error")
+}
+
+func TestHandleCollectPassesPanic(t *testing.T) {
+	var errs []error
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		defer result.HandleCollect(&errs)
+		panic("Expected panic")
+	})
+	assert.Empty(t, errs)
+}
+
+func handleWithCallback(v result.Val[int], onErr func(error)) (res result.Val[int]) {
+	defer result.HandleWithCallback(&res, onErr)
+	return result.NewVal(v.OrError("Context"))
+}
+
+func TestHandleWithCallback(t *testing.T) {
+	var caught []error
+	onErr := func(err error) {
+		caught = append(caught, err)
+	}
+	assert.Equal(t, 1, handleWithCallback(result.NewVal(1), onErr).OrPanic("Unexpected error"))
+	assert.Empty(t, caught)
+	assert.EqualError(t, handleWithCallback(result.ValErrorf[int]("Expected error"), onErr), "Context: Expected error")
+	assert.Len(t, caught, 1)
+	assert.EqualError(t, caught[0], "Context: Expected error")
+}
+
+func orErrorWithStack() (res result.Status) {
+	defer result.HandleStack(&res)
+	result.Error(io.EOF).
+		OrError("Context")
+	return result.Ok()
+}
+
+func TestHandleStack(t *testing.T) {
+	s := orErrorWithStack()
+	assert.EqualError(t, s, "Context: EOF")
+	assert.True(t, errors.Is(s, io.EOF))
+	var se *result.StackError
+	assert.True(t, errors.As(s, &se))
+	assert.Contains(t, string(se.Stack()), "result.Status.OrError")
+	assert.Contains(t, string(se.Stack()), "result_test.orErrorWithStack")
+}
+
+type legacyPanic struct {
+	code int
+}
+
+func handleWithRecover(f func()) (res result.Status) {
+	defer result.HandleWithRecover(&res, func(r any) error {
+		if p, ok := r.(legacyPanic); ok {
+			return fmt.Errorf("Legacy error %v", p.code)
+		}
+		return nil
+	})
+	f()
+	return result.Ok()
+}
+
+func TestHandleWithRecover(t *testing.T) {
+	assert.True(t, handleWithRecover(func() {}).Ok())
+	assert.EqualError(t, handleWithRecover(func() {
+		panic(legacyPanic{2})
+	}), "Legacy error 2")
+	assert.EqualError(t, handleWithRecover(func() {
+		result.Errorf("Expected error").OrError("Context")
+	}), "Context: Expected error")
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		handleWithRecover(func() {
+			panic("Expected panic")
+		})
+	})
+}
+
+func handleChain(order *[]string, f func()) {
+	record := func(name string) func() {
+		return func() {
+			*order = append(*order, name)
+		}
+	}
+	logPanic := func() {
+		r := recover()
+		*order = append(*order, "log")
+		if r != nil {
+			panic(r)
+		}
+	}
+	defer result.HandleChain(record("first"), result.HandleReturn, logPanic)
+	f()
+}
+
+func TestHandleChain(t *testing.T) {
+	order := []string{}
+	handleChain(&order, func() {
+		result.Errorf("Expected error").OrDoAndReturn(func(error) {})
+	})
+	assert.Equal(t, []string{"log", "first"}, order)
+
+	order = []string{}
+	handleChain(&order, func() {})
+	assert.Equal(t, []string{"log", "first"}, order)
+}
+
+func TestHandleChainPassesPanic(t *testing.T) {
+	order := []string{}
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		handleChain(&order, func() {
+			panic("Expected panic")
+		})
+	})
+	assert.Equal(t, []string{"log", "first"}, order)
+}
+
+func TestDeferHandle(t *testing.T) {
+	v := result.DeferHandle(new(result.Val[int]), func() result.Val[int] {
+		a := result.NewVal(1).OrError("Unexpected error")
+		return result.NewVal(a + 1)
+	})
+	assert.Equal(t, 2, v.OrPanic("Unexpected error"))
+
+	var res result.Val[int]
+	v = result.DeferHandle(&res, func() result.Val[int] {
+		a := result.ValErrorf[int]("Expected error").OrError("Context")
+		return result.NewVal(a + 1)
+	})
+	assert.EqualError(t, v, "Context: Expected error")
+	assert.EqualError(t, res, "Context: Expected error")
+}
+
+func deferHandleInside() (res result.Status) {
+	defer result.Handle(&res)
+	result.DeferHandle(new(result.Val[int]), func() result.Val[int] {
+		return result.NewVal(result.ValErrorf[int]("Expected error").OrError("Inner"))
+	})
+	return result.Ok()
+}
+
+func TestDeferHandleIsolated(t *testing.T) {
+	assert.True(t, deferHandleInside().Ok())
+}
@@ -0,0 +1,196 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+	"unsafe"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotPathSize(t *testing.T) {
+	// HotPath is T plus one pointer: one word smaller than Val, but one word bigger than T
+	assert.Equal(t, unsafe.Sizeof(0)+unsafe.Sizeof(uintptr(0)), unsafe.Sizeof(result.HotPath[int]{}))
+	assert.True(t, unsafe.Sizeof(result.HotPath[int]{}) < unsafe.Sizeof(result.Val[int]{}))
+}
+
+func TestHotPathOk(t *testing.T) {
+	h := result.NewHotPath(1)
+	assert.True(t, h.Ok())
+	assert.Equal(t, "", h.Error())
+	assert.Equal(t, 1, h.OrError("Unexpected error"))
+	assert.Equal(t, 1, h.OrPanic("Unexpected error"))
+	assert.Equal(t, 1, h.OrUse(2))
+	assert.Equal(t, 1, h.OrDoAndReturn(func(e error) {
+		t.Errorf("Unexpected error: %v", e)
+	}))
+	assert.Equal(t, 1, result.TryHotPath(1, nil).OrPanic("Unexpected error"))
+}
+
+func TestHotPathError(t *testing.T) {
+	h := result.HotPathErrorf[int]("Expected error %v", 1)
+	assert.False(t, h.Ok())
+	assert.Equal(t, "Expected error 1", h.Error())
+	assert.Equal(t, 2, h.OrUse(2))
+	assert.PanicsWithError(t, "Context: Expected error 1", func() {
+		h.OrPanic("Context")
+	})
+	assert.Equal(t, 2, result.TryHotPath(1, errors.New("Expected error")).OrUse(2))
+}
+
+func hotPathOrError() (res result.HotPath[int]) {
+	defer result.Handle(&res)
+	result.HotPathError[int](errors.New("Expected error")).
+		OrError("Context")
+	return result.NewHotPath(1)
+}
+
+func TestHotPathOrError(t *testing.T) {
+	assert.Equal(t, "Context: Expected error", hotPathOrError().Error())
+}
+
+func TestHotPathOrDoAndReturn(t *testing.T) {
+	defer result.HandleReturn()
+	result.HotPathErrorf[int]("Expected error").
+		OrDoAndReturn(func(e error) {
+			assert.EqualError(t, e, "Expected error")
+		})
+	t.Error("This line should not execute")
+}
+
+// The benchmarks compare Val and HotPath on the ok path. HotPath isn't zero cost: each one carries an extra nil pointer
+// next to its value, so the slice benchmarks move one more word per element than a plain []int would
+var benchSink int
+
+//go:noinline
+func benchVal(i int) result.Val[int] {
+	return result.NewVal(i)
+}
+
+//go:noinline
+func benchHotPath(i int) result.HotPath[int] {
+	return result.NewHotPath(i)
+}
+
+func BenchmarkValOk(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += benchVal(i).OrUse(0)
+	}
+}
+
+func BenchmarkHotPathOk(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += benchHotPath(i).OrUse(0)
+	}
+}
+
+func BenchmarkValSlice(b *testing.B) {
+	vs := make([]result.Val[int], 1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range vs {
+			vs[j] = benchVal(j)
+		}
+		for _, v := range vs {
+			benchSink += v.OrUse(0)
+		}
+	}
+}
+
+func BenchmarkHotPathSlice(b *testing.B) {
+	hs := make([]result.HotPath[int], 1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range hs {
+			hs[j] = benchHotPath(j)
+		}
+		for _, h := range hs {
+			benchSink += h.OrUse(0)
+		}
+	}
+}
+
+func TestHotPathUnwrap(t *testing.T) {
+	assert.Nil(t, result.NewHotPath(1).Unwrap())
+	err := errors.New("Expected error")
+	assert.True(t, errors.Is(result.HotPathError[int](err), err))
+}
+
+func TestHotPathErr(t *testing.T) {
+	assert.Nil(t, result.NewHotPath(1).Err())
+	assert.EqualError(t, result.HotPathErrorf[int]("Expected error").Err(), "Expected error")
+}
+
+func TestHotPathIs(t *testing.T) {
+	assert.False(t, result.NewHotPath(1).Is(io.EOF))
+	assert.True(t, result.HotPathErrorf[int]("Context: %w", io.EOF).Is(io.EOF))
+	assert.False(t, result.HotPathErrorf[int]("Expected error").Is(io.EOF))
+}
+
+func TestHotPathAs(t *testing.T) {
+	var pe *fs.PathError
+	assert.False(t, result.NewHotPath(1).As(&pe))
+	h := result.HotPathErrorf[int]("Context: %w", &fs.PathError{Op: "open", Path: "a", Err: io.EOF})
+	assert.True(t, h.As(&pe))
+	assert.Equal(t, "a", pe.Path)
+}
+
+func TestHotPathMethods(t *testing.T) {
+	ok := result.NewHotPath(1)
+	bad := result.HotPathErrorf[int]("Expected error")
+	assert.Equal(t, 2, bad.OrUseFunc(func() int {
+		return 2
+	}))
+	assert.Equal(t, 1, ok.OrZero())
+	assert.Equal(t, 0, bad.OrZero())
+	v, found := ok.Value()
+	assert.Equal(t, 1, v)
+	assert.True(t, found)
+	_, found = bad.Value()
+	assert.False(t, found)
+	seen := 0
+	ok.AndDo(func(i int) {
+		seen = i
+	})
+	bad.AndDo(func(i int) {
+		t.Errorf("Unexpected call with %v", i)
+	})
+	assert.Equal(t, 1, seen)
+	assert.Equal(t, ok, ok.Annotate("Context"))
+	assert.EqualError(t, bad.Annotate("Context"), "Context: Expected error")
+	assert.EqualError(t, result.DeferHandle(new(result.Val[int]), func() result.Val[int] {
+		return result.NewVal(bad.OrErrorf("Context %v", 1))
+	}), "Context 1: Expected error")
+}
+
+func TestHotPathFormat(t *testing.T) {
+	assert.Equal(t, "5", fmt.Sprint(result.NewHotPath(5)))
+	assert.Equal(t, "005", fmt.Sprintf("%03d", result.NewHotPath(5)))
+	assert.Equal(t, "HotPath[int](5)", fmt.Sprintf("%+v", result.NewHotPath(5)))
+	assert.Equal(t, "result.NewHotPath[int](5)", fmt.Sprintf("%#v", result.NewHotPath(5)))
+	bad := result.HotPathErrorf[int]("Expected error")
+	assert.Equal(t, "Expected error", fmt.Sprint(bad))
+	assert.Equal(t, "HotPath[int](Err(Expected error))", fmt.Sprintf("%+v", bad))
+	assert.Equal(t, `result.HotPathError[int](errors.New("Expected error"))`, fmt.Sprintf("%#v", bad))
+}
+
+func TestHotPathJSON(t *testing.T) {
+	b, err := json.Marshal(result.NewHotPath(5))
+	assert.Nil(t, err)
+	assert.Equal(t, "5", string(b))
+	b, err = json.Marshal(result.HotPathErrorf[int]("Expected error"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":"Expected error"}`, string(b))
+	var h result.HotPath[int]
+	assert.Nil(t, json.Unmarshal([]byte("5"), &h))
+	assert.Equal(t, 5, h.OrPanic("Unexpected error"))
+	assert.Nil(t, json.Unmarshal(b, &h))
+	assert.EqualError(t, h, "Expected error")
+}
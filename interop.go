@@ -0,0 +1,71 @@
+package result
+
+// AsError returns the error of v, or nil if v is ok. It's the inverse of TryVal for code that only needs the error,
+// e.g. a callback that expects an error:
+//     onComplete(func(v result.Val[int]) {
+//         callback(result.AsError(v))
+//     })
+func AsError[T any](v Val[T]) error {
+	return v.err
+}
+
+// AsErrorStatus returns the error of s, or nil if s is ok. It's the inverse of Try
+func AsErrorStatus(s Status) error {
+	return s.err
+}
+
+// Unpack returns the value of v and a nil error if v is ok, or the zero value of T and v's error otherwise. It's the
+// inverse of TryVal, for returning a result from a function that uses the (value, error) convention:
+//     func Port(s string) (int, error) {
+//         return result.Unpack(parsePort(s))
+//     }
+func Unpack[T any](v Val[T]) (T, error) {
+	if v.err != nil {
+		var zero T
+		return zero, v.err
+	}
+	return v.v, nil
+}
+
+// UnpackVals returns the values of v and a nil error if v is ok, or the zero values of T and U and v's error otherwise.
+// It's the inverse of TryVals
+func UnpackVals[T, U any](v Vals[T, U]) (T, U, error) {
+	if v.err != nil {
+		var zero0 T
+		var zero1 U
+		return zero0, zero1, v.err
+	}
+	return v.v0, v.v1, nil
+}
+
+// StoreOk sets *dest to the value of v if v is ok, and leaves it unchanged otherwise. It returns v's Status, so it can
+// be chained, e.g. while filling in a struct:
+//     result.StoreOk(parsePort(s), &cfg.Port).
+//         OrError("Couldn't parse port")
+// StoreOk panics if dest is nil
+func StoreOk[T any](v Val[T], dest *T) Status {
+	if dest == nil {
+		panic("result.StoreOk called with a nil dest")
+	}
+	if v.err != nil {
+		return Error(v.err)
+	}
+	*dest = v.v
+	return Ok()
+}
+
+// StoreOkIf is like StoreOk, but only sets *dest if the value of v also passes pred. If it doesn't, *dest is left
+// unchanged and an error Status is returned. The error doesn't include the value, which may be sensitive
+func StoreOkIf[T any](v Val[T], dest *T, pred func(T) bool) Status {
+	if dest == nil {
+		panic("result.StoreOkIf called with a nil dest")
+	}
+	if v.err != nil {
+		return Error(v.err)
+	}
+	if !pred(v.v) {
+		return Errorf("Value didn't pass the predicate")
+	}
+	*dest = v.v
+	return Ok()
+}
@@ -0,0 +1,73 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsError(t *testing.T) {
+	assert.Nil(t, result.AsError(result.NewVal(1)))
+	err := errors.New("Expected error")
+	assert.True(t, result.AsError(result.ValError[int](err)) == err)
+}
+
+func TestAsErrorStatus(t *testing.T) {
+	assert.Nil(t, result.AsErrorStatus(result.Ok()))
+	err := errors.New("Expected error")
+	assert.True(t, result.AsErrorStatus(result.Error(err)) == err)
+}
+
+func TestStoreOk(t *testing.T) {
+	dest := 0
+	assert.True(t, result.StoreOk(result.NewVal(5), &dest).Ok())
+	assert.Equal(t, 5, dest)
+
+	assert.EqualError(t, result.StoreOk(result.ValErrorf[int]("Expected error"), &dest), "Expected error")
+	assert.Equal(t, 5, dest)
+
+	assert.Panics(t, func() {
+		result.StoreOk(result.NewVal(5), nil)
+	})
+}
+
+func TestStoreOkIf(t *testing.T) {
+	positive := func(i int) bool {
+		return i > 0
+	}
+	dest := 0
+	assert.True(t, result.StoreOkIf(result.NewVal(5), &dest, positive).Ok())
+	assert.Equal(t, 5, dest)
+
+	assert.EqualError(t, result.StoreOkIf(result.NewVal(-1), &dest, positive), "Value didn't pass the predicate")
+	assert.Equal(t, 5, dest)
+
+	assert.EqualError(t, result.StoreOkIf(result.ValErrorf[int]("Expected error"), &dest, positive), "Expected error")
+	assert.Equal(t, 5, dest)
+
+	assert.Panics(t, func() {
+		result.StoreOkIf(result.NewVal(5), nil, positive)
+	})
+}
+
+func TestUnpack(t *testing.T) {
+	i, err := result.Unpack(result.NewVal(1))
+	assert.Equal(t, 1, i)
+	assert.Nil(t, err)
+	i, err = result.Unpack(result.ValErrorf[int]("Expected error"))
+	assert.Equal(t, 0, i)
+	assert.EqualError(t, err, "Expected error")
+}
+
+func TestUnpackVals(t *testing.T) {
+	s, i, err := result.UnpackVals(result.NewVals("a", 1))
+	assert.Equal(t, "a", s)
+	assert.Equal(t, 1, i)
+	assert.Nil(t, err)
+	s, i, err = result.UnpackVals(result.ValsErrorf[string, int]("Expected error"))
+	assert.Equal(t, "", s)
+	assert.Equal(t, 0, i)
+	assert.EqualError(t, err, "Expected error")
+}
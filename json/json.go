@@ -0,0 +1,30 @@
+// Package json converts between results and JSON. It's separate from the result package so that callers who don't need
+// it don't import encoding/json
+package json
+
+import (
+	"encoding/json"
+
+	"github.com/bmheenan/result"
+)
+
+// FromJSON decodes data into a new T with json.Unmarshal, and returns it as a Val. If decoding fails, it returns an
+// error Val. Usage:
+//     cfg := json.FromJSON[Config](data).
+//         OrError("Couldn't decode config")
+func FromJSON[T any](data []byte) result.Val[T] {
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return result.ValError[T](err)
+	}
+	return result.NewVal(t)
+}
+
+// ToJSON encodes the value of v with json.Marshal. If v is an error, or encoding fails, it returns an error Val. Usage:
+//     body := json.ToJSON(fetchUser(id)).
+//         OrError("Couldn't encode user")
+func ToJSON[T any](v result.Val[T]) result.Val[[]byte] {
+	return result.Chain(v, func(t T) result.Val[[]byte] {
+		return result.TryVal(json.Marshal(t))
+	})
+}
@@ -0,0 +1,31 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+	resultjson "github.com/bmheenan/result/json"
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func TestFromJSON(t *testing.T) {
+	p := resultjson.FromJSON[point]([]byte(`{"x":1,"y":2}`)).OrPanic("Unexpected error")
+	assert.Equal(t, point{1, 2}, p)
+	assert.EqualError(
+		t,
+		resultjson.FromJSON[point]([]byte(`{"x":"a"}`)),
+		"json: cannot unmarshal string into Go struct field point.x of type int",
+	)
+}
+
+func TestToJSON(t *testing.T) {
+	b := resultjson.ToJSON(result.NewVal(point{1, 2})).OrPanic("Unexpected error")
+	assert.Equal(t, `{"x":1,"y":2}`, string(b))
+	assert.EqualError(t, resultjson.ToJSON(result.ValErrorf[point]("Expected error")), "Expected error")
+	assert.EqualError(t, resultjson.ToJSON(result.NewVal(func() {})), "json: unsupported type: func()")
+}
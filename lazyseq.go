@@ -0,0 +1,98 @@
+package result
+
+import (
+	"context"
+	"iter"
+)
+
+// LazySeq is a sequence of results that isn't evaluated until it's iterated. Each time it's iterated, its generator is
+// run again
+type LazySeq[T any] struct {
+	gen func(yield func(Val[T]) bool)
+}
+
+// NewLazySeq returns a new LazySeq whose values are produced by gen, following the iter.Seq protocol: gen calls yield
+// for each value, and stops if yield returns false. Usage:
+//     seq := result.NewLazySeq(func(yield func(result.Val[User]) bool) {
+//         for _, id := range ids {
+//             if !yield(fetchUser(id)) {
+//                 return
+//             }
+//         }
+//     })
+func NewLazySeq[T any](gen func(yield func(Val[T]) bool)) *LazySeq[T] {
+	return &LazySeq[T]{
+		gen: gen,
+	}
+}
+
+// All returns the sequence as an iter.Seq, so it can be used with range:
+//     for v := range seq.All() {
+//         // ...
+//     }
+func (s *LazySeq[T]) All() iter.Seq[Val[T]] {
+	return s.gen
+}
+
+// Collect evaluates the sequence and returns all of its values. It stops and returns an error Val at the first error
+// in the sequence
+func (s *LazySeq[T]) Collect() Val[[]T] {
+	vs := []T{}
+	for v := range s.gen {
+		if v.err != nil {
+			return ValError[[]T](v.err)
+		}
+		vs = append(vs, v.v)
+	}
+	return NewVal(vs)
+}
+
+// ForEach evaluates the sequence and calls f for each value. It stops and returns an error Status at the first error in
+// the sequence, the first error Status returned by f, or if ctx is done
+func (s *LazySeq[T]) ForEach(ctx context.Context, f func(T) Status) Status {
+	for v := range s.gen {
+		if err := ctx.Err(); err != nil {
+			return Error(err)
+		}
+		if v.err != nil {
+			return Error(v.err)
+		}
+		if r := f(v.v); r.err != nil {
+			return r
+		}
+	}
+	return Ok()
+}
+
+// Take returns a LazySeq of the first n results of s, whether they're ok or errors
+func (s *LazySeq[T]) Take(n int) *LazySeq[T] {
+	return NewLazySeq(func(yield func(Val[T]) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range s.gen {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	})
+}
+
+// Filter returns a LazySeq that skips the ok results of s whose values don't match pred. Error results are kept
+func (s *LazySeq[T]) Filter(pred func(T) bool) *LazySeq[T] {
+	return NewLazySeq(func(yield func(Val[T]) bool) {
+		for v := range s.gen {
+			if v.err == nil && !pred(v.v) {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingSeq returns a LazySeq of 0 to n-1, with an error in place of failAt, counting generated values in *generated
+func countingSeq(n, failAt int, generated *int) *result.LazySeq[int] {
+	return result.NewLazySeq(func(yield func(result.Val[int]) bool) {
+		for i := 0; i < n; i++ {
+			*generated++
+			v := result.NewVal(i)
+			if i == failAt {
+				v = result.ValErrorf[int]("Error at %v", i)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+func TestLazySeqRange(t *testing.T) {
+	generated := 0
+	seq := countingSeq(3, -1, &generated)
+	assert.Equal(t, 0, generated)
+	got := []int{}
+	for v := range seq.All() {
+		got = append(got, v.OrPanic("Unexpected error"))
+	}
+	assert.Equal(t, []int{0, 1, 2}, got)
+}
+
+func TestLazySeqCollect(t *testing.T) {
+	generated := 0
+	vs := countingSeq(3, -1, &generated).Collect().
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{0, 1, 2}, vs)
+
+	generated = 0
+	assert.EqualError(t, countingSeq(5, 2, &generated).Collect(), "Error at 2")
+	assert.Equal(t, 3, generated)
+}
+
+func TestLazySeqForEach(t *testing.T) {
+	generated := 0
+	sum := 0
+	s := countingSeq(4, -1, &generated).ForEach(context.Background(), func(i int) result.Status {
+		sum += i
+		return result.Ok()
+	})
+	assert.True(t, s.Ok())
+	assert.Equal(t, 6, sum)
+
+	s = countingSeq(4, -1, &generated).ForEach(context.Background(), func(i int) result.Status {
+		return result.Errorf("Rejected %v", i)
+	})
+	assert.EqualError(t, s, "Rejected 0")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s = countingSeq(4, -1, &generated).ForEach(ctx, func(i int) result.Status {
+		t.Error("f called with a cancelled context")
+		return result.Ok()
+	})
+	assert.EqualError(t, s, "context canceled")
+}
+
+func TestLazySeqTake(t *testing.T) {
+	generated := 0
+	vs := countingSeq(100, -1, &generated).Take(3).Collect().
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{0, 1, 2}, vs)
+	assert.Equal(t, 3, generated)
+	assert.Equal(t, []int{}, countingSeq(3, -1, &generated).Take(0).Collect().OrPanic("Unexpected error"))
+}
+
+func TestLazySeqFilter(t *testing.T) {
+	generated := 0
+	vs := countingSeq(10, -1, &generated).
+		Filter(func(i int) bool {
+			return i%2 == 0
+		}).
+		Take(3).
+		Collect().
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{0, 2, 4}, vs)
+	assert.Equal(t, 5, generated)
+
+	assert.EqualError(
+		t,
+		countingSeq(10, 3, &generated).Filter(func(i int) bool { return i%2 == 0 }).Collect(),
+		"Error at 3",
+	)
+}
@@ -0,0 +1,131 @@
+package result
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// jsonError is the JSON form of an error result
+type jsonError struct {
+	Error string `json:"error"`
+}
+
+// MarshalJSON encodes the value of the Val as JSON if it's ok. If it's an error, it's encoded as an object with the
+// error message, e.g. {"error":"Couldn't fetch user"}. This lets a Val be used as a field in a struct that's encoded
+// to JSON, e.g. an API response
+func (v Val[T]) MarshalJSON() ([]byte, error) {
+	if v.err != nil {
+		return json.Marshal(jsonError{v.err.Error()})
+	}
+	return json.Marshal(v.v)
+}
+
+// UnmarshalJSON decodes data into the Val. If data is an error object, as encoded by MarshalJSON, the Val becomes an
+// error with its message. Otherwise, data is decoded into a T, and the Val is ok. An object whose only field is a string
+// named "error" is always treated as an error object, even if T could be decoded from it
+func (v *Val[T]) UnmarshalJSON(data []byte) error {
+	if msg, ok := jsonErrorMessage(data); ok {
+		*v = ValError[T](errors.New(msg))
+		return nil
+	}
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*v = NewVal(t)
+	return nil
+}
+
+// jsonErrorMessage returns the message of data if it's an error object, as encoded by Val's MarshalJSON
+func jsonErrorMessage(data []byte) (string, bool) {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil || len(fields) != 1 {
+		return "", false
+	}
+	raw, ok := fields["error"]
+	if !ok {
+		return "", false
+	}
+	var msg string
+	if json.Unmarshal(raw, &msg) != nil {
+		return "", false
+	}
+	return msg, true
+}
+
+// jsonStatus is the JSON form of a Status
+type jsonStatus struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the Status as a JSON object, e.g. {"ok":true}, or {"ok":false,"error":"Couldn't save user"}
+func (s Status) MarshalJSON() ([]byte, error) {
+	if s.err != nil {
+		return json.Marshal(jsonStatus{Ok: false, Error: s.err.Error()})
+	}
+	return json.Marshal(jsonStatus{Ok: true})
+}
+
+// UnmarshalJSON decodes a JSON object, as encoded by MarshalJSON, into the Status
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var js jsonStatus
+	if err := json.Unmarshal(data, &js); err != nil {
+		return err
+	}
+	switch {
+	case js.Ok:
+		*s = Ok()
+	case js.Error == "":
+		*s = Errorf("Status wasn't ok")
+	default:
+		*s = Error(errors.New(js.Error))
+	}
+	return nil
+}
+
+// TextResult is a Val whose value implements encoding.TextMarshaler, so that it can implement encoding.TextMarshaler
+// itself. Val can't, since a method can't require more of T than the type does. Create one with TextVal
+type TextResult[T encoding.TextMarshaler] struct {
+	Val[T]
+}
+
+// TextVal encloses a function that returns a value that implements encoding.TextMarshaler and an error, like TryVal,
+// and returns its result as a TextResult. Usage:
+//     addr := result.TextVal(netip.ParseAddr(s))
+func TextVal[T encoding.TextMarshaler](v T, err error) TextResult[T] {
+	return TextResult[T]{TryVal(v, err)}
+}
+
+// MarshalText encodes the value with its MarshalText method if it's ok. If it's an error, the error is returned
+func (t TextResult[T]) MarshalText() ([]byte, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return t.v.MarshalText()
+}
+
+// UnmarshalText decodes text into the value with its UnmarshalText method, and makes the TextResult ok. *T must
+// implement encoding.TextUnmarshaler, or if T is a pointer such as *big.Int, T itself can, and a new value is allocated
+// for it to point to. If decoding fails, the error is returned and the TextResult is unchanged
+func (t *TextResult[T]) UnmarshalText(text []byte) error {
+	var v T
+	u, ok := any(&v).(encoding.TextUnmarshaler)
+	if !ok {
+		if rt := reflect.TypeOf(v); rt != nil && rt.Kind() == reflect.Pointer {
+			v = reflect.New(rt.Elem()).Interface().(T)
+			u, ok = any(v).(encoding.TextUnmarshaler)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("%v doesn't implement encoding.TextUnmarshaler", typeName[*T]())
+	}
+	if err := u.UnmarshalText(text); err != nil {
+		return err
+	}
+	t.Val = NewVal(v)
+	return nil
+}
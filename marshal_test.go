@@ -0,0 +1,113 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+type userResponse struct {
+	Name result.Val[string] `json:"name"`
+	Age  result.Val[int]    `json:"age"`
+}
+
+func TestValMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(userResponse{
+		Name: result.NewVal("a"),
+		Age:  result.ValErrorf[int]("Age unknown"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"a","age":{"error":"Age unknown"}}`, string(b))
+}
+
+func TestValUnmarshalJSON(t *testing.T) {
+	var u userResponse
+	err := json.Unmarshal([]byte(`{"name":"a","age":{"error":"Age unknown"}}`), &u)
+	assert.Nil(t, err)
+	assert.Equal(t, "a", u.Name.OrPanic("Unexpected error"))
+	assert.EqualError(t, u.Age, "Age unknown")
+
+	err = json.Unmarshal([]byte(`{"name":1}`), &u)
+	assert.NotNil(t, err)
+}
+
+func TestValJSONRoundTrip(t *testing.T) {
+	in := result.NewVal(map[string]int{"a": 1, "error": 2})
+	b, err := json.Marshal(in)
+	assert.Nil(t, err)
+	var out result.Val[map[string]int]
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, map[string]int{"a": 1, "error": 2}, out.OrPanic("Unexpected error"))
+}
+
+func TestStatusMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(result.Ok())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok":true}`, string(b))
+	b, err = json.Marshal(result.Errorf("Expected error"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok":false,"error":"Expected error"}`, string(b))
+}
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	var s result.Status
+	assert.Nil(t, json.Unmarshal([]byte(`{"ok":false,"error":"Expected error"}`), &s))
+	assert.EqualError(t, s, "Expected error")
+	assert.Nil(t, json.Unmarshal([]byte(`{"ok":true}`), &s))
+	assert.True(t, s.Ok())
+	assert.Nil(t, json.Unmarshal([]byte(`{"ok":false}`), &s))
+	assert.EqualError(t, s, "Status wasn't ok")
+}
+
+func TestTextResultMarshalText(t *testing.T) {
+	b, err := result.TextVal(netip.ParseAddr("127.0.0.1")).MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1", string(b))
+	_, err = result.TextVal(netip.Addr{}, errors.New("Expected error")).MarshalText()
+	assert.EqualError(t, err, "Expected error")
+}
+
+func TestTextResultUnmarshalText(t *testing.T) {
+	var addr result.TextResult[netip.Addr]
+	assert.Nil(t, addr.UnmarshalText([]byte("127.0.0.1")))
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), addr.OrPanic("Unexpected error"))
+	assert.NotNil(t, addr.UnmarshalText([]byte("x")))
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), addr.OrPanic("Unexpected error"))
+}
+
+func TestTextResultUnmarshalTextPointer(t *testing.T) {
+	var n result.TextResult[*big.Int]
+	assert.Nil(t, n.UnmarshalText([]byte("123")))
+	assert.Equal(t, big.NewInt(123), n.OrPanic("Unexpected error"))
+	assert.NotNil(t, n.UnmarshalText([]byte("x")))
+	assert.Equal(t, big.NewInt(123), n.OrPanic("Unexpected error"))
+}
+
+// textOnly implements encoding.TextMarshaler, but not encoding.TextUnmarshaler
+type textOnly struct{}
+
+func (textOnly) MarshalText() ([]byte, error) {
+	return []byte("text"), nil
+}
+
+func TestTextResultUnmarshalTextUnsupported(t *testing.T) {
+	var v result.TextResult[textOnly]
+	assert.EqualError(
+		t,
+		v.UnmarshalText([]byte("text")),
+		"*result_test.textOnly doesn't implement encoding.TextUnmarshaler",
+	)
+}
+
+func TestTextResultJSONKey(t *testing.T) {
+	b, err := json.Marshal(map[result.TextResult[netip.Addr]]int{
+		result.TextVal(netip.ParseAddr("10.0.0.1")): 1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"10.0.0.1":1}`, string(b))
+}
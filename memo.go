@@ -0,0 +1,88 @@
+package result
+
+import (
+	"sync"
+	"time"
+)
+
+// Lazy returns a function that returns f's value as an ok Val. f isn't called until the returned function is first
+// called, and is only ever called once; later calls return the same value. If f panics, the panic is recovered, and
+// every call returns the error. Lazy returns a function rather than a Val, because a Val always holds its value and
+// can't put off computing it. It's safe for concurrent use, and can be used at package level, e.g:
+//     var defaultUser = result.Lazy(func() User {
+//         return loadUserFromFile()
+//     })
+func Lazy[T any](f func() T) func() Val[T] {
+	var once sync.Once
+	var v Val[T]
+	return func() Val[T] {
+		once.Do(func() {
+			v = safeCall(func() Val[T] {
+				return NewVal(f())
+			})
+		})
+		return v
+	}
+}
+
+// Once returns a function that calls f the first time it's called, and returns the same result, ok or error, every
+// time after that without calling f again. If f panics, the panic is recovered and the error is cached. It's safe for
+// concurrent use. To retry a failing f before caching its result, wrap it with Retry. Usage:
+//     var discover = result.Once(func() result.Val[[]string] {
+//         return lookupServiceAddrs("prices")
+//     })
+func Once[T any](f func() Val[T]) func() Val[T] {
+	var once sync.Once
+	var v Val[T]
+	return func() Val[T] {
+		once.Do(func() {
+			v = safeCall(f)
+		})
+		return v
+	}
+}
+
+// CacheOptions configures how Cache treats error results
+type CacheOptions struct {
+	// SkipErrors means error results aren't cached, so the next call calls f again
+	SkipErrors bool
+	// ErrorTTL is how long error results are cached. If it's 0, they're cached for the same ttl as ok results
+	ErrorTTL time.Duration
+}
+
+// Cache is like Once, but calls f again once its cached result is older than ttl. By default, error results are cached
+// too, so a failing f isn't called on every call during an outage; pass CacheOptions to change that. It's safe for
+// concurrent use, and f is only called by one caller at a time. Usage:
+//     var rates = result.Cache(fetchExchangeRates, time.Hour)
+//     r := rates().
+//         OrError("Couldn't get exchange rates")
+func Cache[T any](f func() Val[T], ttl time.Duration, opts ...CacheOptions) func() Val[T] {
+	var opt CacheOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	errorTTL := ttl
+	if opt.ErrorTTL > 0 {
+		errorTTL = opt.ErrorTTL
+	}
+	var mu sync.Mutex
+	var v Val[T]
+	var expires time.Time
+	return func() Val[T] {
+		mu.Lock()
+		defer mu.Unlock()
+		if time.Now().Before(expires) {
+			return v
+		}
+		v = safeCall(f)
+		switch {
+		case v.err == nil:
+			expires = time.Now().Add(ttl)
+		case opt.SkipErrors:
+			expires = time.Time{}
+		default:
+			expires = time.Now().Add(errorTTL)
+		}
+		return v
+	}
+}
@@ -0,0 +1,129 @@
+package result_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	f := result.Lazy(func() int {
+		calls++
+		return 5
+	})
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 5, f().OrPanic("Unexpected error"))
+	assert.Equal(t, 5, f().OrPanic("Unexpected error"))
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazyConcurrent(t *testing.T) {
+	var calls int32
+	f := result.Lazy(func() int {
+		atomic.AddInt32(&calls, 1)
+		return 5
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 5, f().OrPanic("Unexpected error"))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestLazyPanic(t *testing.T) {
+	calls := 0
+	f := result.Lazy(func() int {
+		calls++
+		panic("Expected panic")
+	})
+	assert.EqualError(t, f(), "panic: Expected panic")
+	assert.EqualError(t, f(), "panic: Expected panic")
+	assert.Equal(t, 1, calls)
+}
+
+func TestOnce(t *testing.T) {
+	calls := 0
+	f := result.Once(func() result.Val[int] {
+		calls++
+		return result.NewVal(calls)
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, f().OrPanic("Unexpected error"))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, calls)
+}
+
+func TestOnceCachesError(t *testing.T) {
+	calls := 0
+	f := result.Once(func() result.Val[int] {
+		calls++
+		return result.ValErrorf[int]("Expected error %v", calls)
+	})
+	assert.EqualError(t, f(), "Expected error 1")
+	assert.EqualError(t, f(), "Expected error 1")
+
+	p := result.Once(func() result.Val[int] {
+		panic("Expected panic")
+	})
+	assert.EqualError(t, p(), "panic: Expected panic")
+	assert.EqualError(t, p(), "panic: Expected panic")
+}
+
+// counter returns a function that counts its calls, and fails on the calls in fail
+func counter(fail ...int) (func() result.Val[int], *int) {
+	calls := 0
+	return func() result.Val[int] {
+		calls++
+		for _, f := range fail {
+			if calls == f {
+				return result.ValErrorf[int]("Expected error %v", calls)
+			}
+		}
+		return result.NewVal(calls)
+	}, &calls
+}
+
+func TestCache(t *testing.T) {
+	f, calls := counter()
+	c := result.Cache(f, 20*time.Millisecond)
+	assert.Equal(t, 1, c().OrPanic("Unexpected error"))
+	assert.Equal(t, 1, c().OrPanic("Unexpected error"))
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, 2, c().OrPanic("Unexpected error"))
+	assert.Equal(t, 2, *calls)
+}
+
+func TestCacheErrors(t *testing.T) {
+	f, _ := counter(1)
+	c := result.Cache(f, time.Hour)
+	assert.EqualError(t, c(), "Expected error 1")
+	assert.EqualError(t, c(), "Expected error 1")
+
+	f, _ = counter(1)
+	c = result.Cache(f, time.Hour, result.CacheOptions{SkipErrors: true})
+	assert.EqualError(t, c(), "Expected error 1")
+	assert.Equal(t, 2, c().OrPanic("Unexpected error"))
+	assert.Equal(t, 2, c().OrPanic("Unexpected error"))
+
+	f, _ = counter(1)
+	c = result.Cache(f, time.Hour, result.CacheOptions{ErrorTTL: 10 * time.Millisecond})
+	assert.EqualError(t, c(), "Expected error 1")
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 2, c().OrPanic("Unexpected error"))
+}
@@ -0,0 +1,47 @@
+package result
+
+// Merge2 combines the values of t and u with f if both are ok. Otherwise, it returns the first error without calling
+// f. Usage:
+//     name := result.Merge2(firstName(id), lastName(id), func(first, last string) string {
+//         return first + " " + last
+//     })
+func Merge2[T, U, V any](t Val[T], u Val[U], f func(T, U) V) Val[V] {
+	if t.err != nil {
+		return ValError[V](t.err)
+	}
+	if u.err != nil {
+		return ValError[V](u.err)
+	}
+	return NewVal(f(t.v, u.v))
+}
+
+// Merge3 is like Merge2, for three results
+func Merge3[T, U, V, W any](t Val[T], u Val[U], v Val[V], f func(T, U, V) W) Val[W] {
+	if t.err != nil {
+		return ValError[W](t.err)
+	}
+	if u.err != nil {
+		return ValError[W](u.err)
+	}
+	if v.err != nil {
+		return ValError[W](v.err)
+	}
+	return NewVal(f(t.v, u.v, v.v))
+}
+
+// Merge4 is like Merge2, for four results
+func Merge4[T, U, V, W, X any](t Val[T], u Val[U], v Val[V], w Val[W], f func(T, U, V, W) X) Val[X] {
+	if t.err != nil {
+		return ValError[X](t.err)
+	}
+	if u.err != nil {
+		return ValError[X](u.err)
+	}
+	if v.err != nil {
+		return ValError[X](v.err)
+	}
+	if w.err != nil {
+		return ValError[X](w.err)
+	}
+	return NewVal(f(t.v, u.v, v.v, w.v))
+}
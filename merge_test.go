@@ -0,0 +1,71 @@
+package result_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func merge3(a int, b string, c bool) string {
+	return fmt.Sprint(a, b, c)
+}
+
+func TestMerge2(t *testing.T) {
+	add := func(a, b int) int {
+		return a + b
+	}
+	assert.Equal(t, 3, result.Merge2(result.NewVal(1), result.NewVal(2), add).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Merge2(result.ValErrorf[int]("Error a"), result.ValErrorf[int]("Error b"), add), "Error a")
+	assert.EqualError(t, result.Merge2(result.NewVal(1), result.ValErrorf[int]("Error b"), add), "Error b")
+}
+
+func TestMerge3AllOk(t *testing.T) {
+	s := result.Merge3(result.NewVal(1), result.NewVal("b"), result.NewVal(true), merge3).
+		OrPanic("Unexpected error")
+	assert.Equal(t, "1btrue", s)
+}
+
+func TestMerge3Errors(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Merge3(result.ValErrorf[int]("Error a"), result.NewVal("b"), result.ValErrorf[bool]("Error c"), merge3),
+		"Error a",
+	)
+	assert.EqualError(
+		t,
+		result.Merge3(result.NewVal(1), result.ValErrorf[string]("Error b"), result.ValErrorf[bool]("Error c"), merge3),
+		"Error b",
+	)
+	assert.EqualError(
+		t,
+		result.Merge3(result.NewVal(1), result.NewVal("b"), result.ValErrorf[bool]("Error c"), merge3),
+		"Error c",
+	)
+}
+
+func TestMerge3Panics(t *testing.T) {
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		result.Merge3(result.NewVal(1), result.NewVal("b"), result.NewVal(true), func(int, string, bool) string {
+			panic("Expected panic")
+		})
+	})
+}
+
+func TestMerge4(t *testing.T) {
+	sum := func(a, b, c, d int) int {
+		return a + b + c + d
+	}
+	assert.Equal(
+		t,
+		10,
+		result.Merge4(result.NewVal(1), result.NewVal(2), result.NewVal(3), result.NewVal(4), sum).
+			OrPanic("Unexpected error"),
+	)
+	assert.EqualError(
+		t,
+		result.Merge4(result.NewVal(1), result.NewVal(2), result.NewVal(3), result.ValErrorf[int]("Error d"), sum),
+		"Error d",
+	)
+}
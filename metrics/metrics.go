@@ -0,0 +1,42 @@
+// Package metrics records metrics about results. It defines its own Counter and Histogram interfaces, which match the
+// methods of Prometheus's, so it doesn't depend on any metrics library
+package metrics
+
+import (
+	"time"
+
+	"github.com/bmheenan/result"
+)
+
+// Counter is a metric that only goes up, like prometheus.Counter
+type Counter interface {
+	Inc()
+	Add(float64)
+}
+
+// Histogram is a metric that records a distribution of observations, like prometheus.Histogram
+type Histogram interface {
+	Observe(float64)
+}
+
+// WithMetrics increments okCounter if v is ok, or errCounter if it's an error, then returns v unchanged. Usage:
+//     user := metrics.WithMetrics(fetchUser(id), fetchOk, fetchErrors).
+//         OrError("Couldn't fetch user")
+func WithMetrics[T any](v result.Val[T], okCounter, errCounter Counter) result.Val[T] {
+	if v.Ok() {
+		okCounter.Inc()
+		return v
+	}
+	errCounter.Inc()
+	return v
+}
+
+// WithLatency observes the duration returned by durationFn in histogram, in seconds, then returns v unchanged. Usage:
+//     start := time.Now()
+//     user := metrics.WithLatency(fetchUser(id), fetchLatency, func() time.Duration {
+//         return time.Since(start)
+//     })
+func WithLatency[T any](v result.Val[T], histogram Histogram, durationFn func() time.Duration) result.Val[T] {
+	histogram.Observe(durationFn().Seconds())
+	return v
+}
@@ -0,0 +1,52 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/bmheenan/result/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCounter struct {
+	n float64
+}
+
+func (c *mockCounter) Inc() {
+	c.n++
+}
+
+func (c *mockCounter) Add(f float64) {
+	c.n += f
+}
+
+type mockHistogram struct {
+	observed []float64
+}
+
+func (h *mockHistogram) Observe(f float64) {
+	h.observed = append(h.observed, f)
+}
+
+func TestWithMetrics(t *testing.T) {
+	ok, errs := &mockCounter{}, &mockCounter{}
+	v := metrics.WithMetrics(result.NewVal(1), ok, errs)
+	assert.Equal(t, 1, v.OrPanic("Unexpected error"))
+	assert.Equal(t, 1.0, ok.n)
+	assert.Equal(t, 0.0, errs.n)
+
+	v = metrics.WithMetrics(result.ValErrorf[int]("Expected error"), ok, errs)
+	assert.EqualError(t, v, "Expected error")
+	assert.Equal(t, 1.0, ok.n)
+	assert.Equal(t, 1.0, errs.n)
+}
+
+func TestWithLatency(t *testing.T) {
+	h := &mockHistogram{}
+	v := metrics.WithLatency(result.ValErrorf[int]("Expected error"), h, func() time.Duration {
+		return 1500 * time.Millisecond
+	})
+	assert.EqualError(t, v, "Expected error")
+	assert.Equal(t, []float64{1.5}, h.observed)
+}
@@ -0,0 +1,73 @@
+package result
+
+import (
+	"context"
+)
+
+// Page is one page of items from a paginated data source. NextCursor is passed to the next fetch if HasMore is true
+type Page[T any] struct {
+	Items      []T
+	NextCursor any
+	HasMore    bool
+}
+
+// Paged iterates over a cursor-based, paginated data source, where fetching each page may fail
+type Paged[T any] struct {
+	fetch func(cursor any) Val[Page[T]]
+}
+
+// NewPaged returns a new Paged that gets its pages from fetch. The first page is fetched with a nil cursor, and each
+// following page with the NextCursor of the page before it. Usage:
+//     users := result.NewPaged(func(cursor any) result.Val[result.Page[User]] {
+//         return listUsers(cursor)
+//     })
+func NewPaged[T any](fetch func(cursor any) Val[Page[T]]) *Paged[T] {
+	return &Paged[T]{
+		fetch: fetch,
+	}
+}
+
+// ForEach fetches every page in order and calls f for each item. It stops and returns an error Status if fetching a
+// page fails, if f returns an error Status, or if ctx is done. Usage:
+//     users.ForEach(ctx, func(u User) result.Status {
+//         return notify(u)
+//     }).
+//         OrError("Couldn't notify all users")
+func (p *Paged[T]) ForEach(ctx context.Context, f func(T) Status) Status {
+	var cursor any
+	for n := 0; ; n++ {
+		if err := ctx.Err(); err != nil {
+			return Error(err)
+		}
+		page := p.fetch(cursor)
+		if page.err != nil {
+			return Errorf("Couldn't fetch page %v: %w", n, page.err)
+		}
+		for _, item := range page.v.Items {
+			if err := ctx.Err(); err != nil {
+				return Error(err)
+			}
+			if s := f(item); s.err != nil {
+				return s
+			}
+		}
+		if !page.v.HasMore {
+			return Ok()
+		}
+		cursor = page.v.NextCursor
+	}
+}
+
+// Collect fetches every page in order and returns all of their items. It returns an error Val if fetching a page fails
+// or if ctx is done
+func (p *Paged[T]) Collect(ctx context.Context) Val[[]T] {
+	items := []T{}
+	s := p.ForEach(ctx, func(item T) Status {
+		items = append(items, item)
+		return Ok()
+	})
+	if s.err != nil {
+		return ValError[[]T](s.err)
+	}
+	return NewVal(items)
+}
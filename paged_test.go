@@ -0,0 +1,74 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedFetch returns a fetch func serving pages of 2 ints each. If failOn >= 0, fetching that page returns an error
+func pagedFetch(pages, failOn int, fetches *int) func(cursor any) result.Val[result.Page[int]] {
+	return func(cursor any) result.Val[result.Page[int]] {
+		*fetches++
+		n := 0
+		if cursor != nil {
+			n = cursor.(int)
+		}
+		if n == failOn {
+			return result.ValErrorf[result.Page[int]]("Expected error")
+		}
+		return result.NewVal(result.Page[int]{
+			Items:      []int{n * 2, n*2 + 1},
+			NextCursor: n + 1,
+			HasMore:    n+1 < pages,
+		})
+	}
+}
+
+func TestPagedCollect(t *testing.T) {
+	fetches := 0
+	items := result.NewPaged(pagedFetch(3, -1, &fetches)).
+		Collect(context.Background()).
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, items)
+	assert.Equal(t, 3, fetches)
+}
+
+func TestPagedCollectFetchError(t *testing.T) {
+	fetches := 0
+	assert.EqualError(
+		t,
+		result.NewPaged(pagedFetch(3, 1, &fetches)).Collect(context.Background()),
+		"Couldn't fetch page 1: Expected error",
+	)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestPagedForEachStopsOnError(t *testing.T) {
+	fetches := 0
+	seen := []int{}
+	s := result.NewPaged(pagedFetch(3, -1, &fetches)).ForEach(context.Background(), func(i int) result.Status {
+		if i == 3 {
+			return result.Errorf("Expected error")
+		}
+		seen = append(seen, i)
+		return result.Ok()
+	})
+	assert.EqualError(t, s, "Expected error")
+	assert.Equal(t, []int{0, 1, 2}, seen)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestPagedForEachCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	fetches := 0
+	s := result.NewPaged(pagedFetch(3, -1, &fetches)).ForEach(ctx, func(i int) result.Status {
+		t.Error("f called with a cancelled context")
+		return result.Ok()
+	})
+	assert.EqualError(t, s, context.Canceled.Error())
+	assert.Equal(t, 0, fetches)
+}
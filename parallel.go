@@ -0,0 +1,152 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Parallel2 calls ft and fu in separate goroutines, waits for both to finish, and returns their results. If either
+// panics, the panic is recovered and returned as an error result. Usage:
+//     user, orders := result.Parallel2(fetchUser, fetchOrders)
+func Parallel2[T, U any](ft func() Val[T], fu func() Val[U]) (Val[T], Val[U]) {
+	var t Val[T]
+	var u Val[U]
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		t = safeCall(ft)
+	}()
+	go func() {
+		defer wg.Done()
+		u = safeCall(fu)
+	}()
+	wg.Wait()
+	return t, u
+}
+
+// Parallel3 is like Parallel2, for three functions
+func Parallel3[T, U, V any](ft func() Val[T], fu func() Val[U], fv func() Val[V]) (Val[T], Val[U], Val[V]) {
+	var t Val[T]
+	var u Val[U]
+	var v Val[V]
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		t = safeCall(ft)
+	}()
+	go func() {
+		defer wg.Done()
+		u = safeCall(fu)
+	}()
+	go func() {
+		defer wg.Done()
+		v = safeCall(fv)
+	}()
+	wg.Wait()
+	return t, u, v
+}
+
+// Race calls each of funcs in a separate goroutine, and returns the first ok result. Once there's a result, the context
+// passed to the remaining funcs is canceled. If all of funcs fail, Race returns an error combining all their errors. If
+// ctx is done before there's a result, Race returns an error wrapping ctx.Err(). Usage:
+//     price := result.Race(ctx, fetchPriceFromPrimary, fetchPriceFromReplica).
+//         OrError("Couldn't fetch price")
+func Race[T any](ctx context.Context, funcs ...func(context.Context) Val[T]) Val[T] {
+	if len(funcs) == 0 {
+		return ValErrorf[T]("Race needs at least one function")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Buffered so that goroutines finishing after Race returns don't block
+	ch := make(chan Val[T], len(funcs))
+	for _, f := range funcs {
+		go func() {
+			ch <- safeCall(func() Val[T] {
+				return f(ctx)
+			})
+		}()
+	}
+	errs := make([]error, 0, len(funcs))
+	for range funcs {
+		select {
+		case v := <-ch:
+			if v.err == nil {
+				return v
+			}
+			errs = append(errs, v.err)
+		case <-ctx.Done():
+			return ValErrorf[T]("Race stopped before any function succeeded: %w", ctx.Err())
+		}
+	}
+	return ValError[T](errors.Join(errs...))
+}
+
+// ParallelAll calls each of funcs in a separate goroutine, and returns all of their values, in the same order as funcs.
+// If any of funcs fails, the context passed to the rest is canceled, and ParallelAll returns the first error without
+// waiting for them. If ctx is done first, ParallelAll returns an error wrapping ctx.Err(). Usage:
+//     pages := result.ParallelAll(ctx, fetchPage1, fetchPage2, fetchPage3).
+//         OrError("Couldn't fetch pages")
+func ParallelAll[T any](ctx context.Context, funcs ...func(context.Context) Val[T]) Val[[]T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type indexed struct {
+		i int
+		v Val[T]
+	}
+	ch := make(chan indexed, len(funcs))
+	for i, f := range funcs {
+		go func() {
+			ch <- indexed{i, safeCall(func() Val[T] {
+				return f(ctx)
+			})}
+		}()
+	}
+	vs := make([]T, len(funcs))
+	for range funcs {
+		select {
+		case r := <-ch:
+			if r.v.err != nil {
+				return ValError[[]T](r.v.err)
+			}
+			vs[r.i] = r.v.v
+		case <-ctx.Done():
+			return ValErrorf[[]T]("Stopped waiting for parallel results: %w", ctx.Err())
+		}
+	}
+	return NewVal(vs)
+}
+
+// ParallelCollect calls each of funcs in a separate goroutine, waits for all of them to finish, and returns their
+// results in the same order as funcs. Unlike ParallelAll, a failure doesn't cancel the others, so the ok results are
+// available even if some fail. Usage:
+//     for i, v := range result.ParallelCollect(ctx, fetchers...) {
+//         // ...
+//     }
+func ParallelCollect[T any](ctx context.Context, funcs ...func(context.Context) Val[T]) []Val[T] {
+	vs := make([]Val[T], len(funcs))
+	var wg sync.WaitGroup
+	wg.Add(len(funcs))
+	for i, f := range funcs {
+		go func() {
+			defer wg.Done()
+			vs[i] = safeCall(func() Val[T] {
+				return f(ctx)
+			})
+		}()
+	}
+	wg.Wait()
+	return vs
+}
+
+// safeCall returns the result of f, or an error Val if f panics
+func safeCall[T any](f func() Val[T]) (v Val[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			v = ValError[T](panicError(r))
+		}
+	}()
+	return f()
+}
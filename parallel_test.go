@@ -0,0 +1,169 @@
+package result_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallel2(t *testing.T) {
+	a, b := result.Parallel2(
+		func() result.Val[int] {
+			return result.NewVal(1)
+		},
+		func() result.Val[string] {
+			return result.ValErrorf[string]("Expected error")
+		},
+	)
+	assert.Equal(t, 1, a.OrPanic("Unexpected error"))
+	assert.EqualError(t, b, "Expected error")
+}
+
+func TestParallel3Concurrent(t *testing.T) {
+	// Each function waits until all three have started, so this only finishes if they run concurrently
+	var started sync.WaitGroup
+	started.Add(3)
+	wait := func() {
+		started.Done()
+		started.Wait()
+		time.Sleep(time.Millisecond)
+	}
+	a, b, c := result.Parallel3(
+		func() result.Val[int] {
+			wait()
+			return result.NewVal(1)
+		},
+		func() result.Val[string] {
+			wait()
+			return result.NewVal("b")
+		},
+		func() result.Val[bool] {
+			wait()
+			return result.NewVal(true)
+		},
+	)
+	assert.Equal(t, 1, a.OrPanic("Unexpected error"))
+	assert.Equal(t, "b", b.OrPanic("Unexpected error"))
+	assert.Equal(t, true, c.OrPanic("Unexpected error"))
+}
+
+func TestParallel3Panics(t *testing.T) {
+	ok := func() result.Val[int] {
+		return result.NewVal(1)
+	}
+	panics := func() result.Val[int] {
+		panic("Expected panic")
+	}
+	for i := 0; i < 3; i++ {
+		fs := []func() result.Val[int]{ok, ok, ok}
+		fs[i] = panics
+		a, b, c := result.Parallel3(fs[0], fs[1], fs[2])
+		vs := []result.Val[int]{a, b, c}
+		for j, v := range vs {
+			if j == i {
+				assert.EqualError(t, v, "panic: Expected panic")
+				continue
+			}
+			assert.True(t, v.Ok())
+		}
+	}
+}
+
+func TestRaceFirstOk(t *testing.T) {
+	v := result.Race(context.Background(),
+		func(ctx context.Context) result.Val[string] {
+			<-ctx.Done()
+			return result.ValError[string](ctx.Err())
+		},
+		func(ctx context.Context) result.Val[string] {
+			return result.ValErrorf[string]("Expected error")
+		},
+		func(ctx context.Context) result.Val[string] {
+			time.Sleep(10 * time.Millisecond)
+			return result.NewVal("fast")
+		},
+	)
+	assert.Equal(t, "fast", v.OrPanic("Unexpected error"))
+}
+
+func TestRaceAllFail(t *testing.T) {
+	fail := func(msg string) func(context.Context) result.Val[int] {
+		return func(context.Context) result.Val[int] {
+			return result.ValErrorf[int](msg)
+		}
+	}
+	v := result.Race(context.Background(), fail("Expected error"), fail("Expected error"))
+	assert.EqualError(t, v, "Expected error\nExpected error")
+	assert.EqualError(t, result.Race[int](context.Background()), "Race needs at least one function")
+}
+
+func TestRaceCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	v := result.Race(ctx, func(ctx context.Context) result.Val[int] {
+		select {
+		case <-time.After(time.Second):
+			return result.NewVal(1)
+		case <-ctx.Done():
+			return result.ValError[int](ctx.Err())
+		}
+	})
+	assert.EqualError(t, v, "Race stopped before any function succeeded: context canceled")
+}
+
+func TestParallelAll(t *testing.T) {
+	delayed := func(d time.Duration, i int) func(context.Context) result.Val[int] {
+		return func(context.Context) result.Val[int] {
+			time.Sleep(d)
+			return result.NewVal(i)
+		}
+	}
+	vs := result.ParallelAll(context.Background(),
+		delayed(20*time.Millisecond, 1),
+		delayed(0, 2),
+		delayed(10*time.Millisecond, 3),
+	).OrPanic("Unexpected error")
+	assert.Equal(t, []int{1, 2, 3}, vs)
+	assert.Equal(t, []int{}, result.ParallelAll[int](context.Background()).OrPanic("Unexpected error"))
+}
+
+func TestParallelAllError(t *testing.T) {
+	canceled := make(chan bool, 1)
+	v := result.ParallelAll(context.Background(),
+		func(ctx context.Context) result.Val[int] {
+			<-ctx.Done()
+			canceled <- true
+			return result.ValError[int](ctx.Err())
+		},
+		func(context.Context) result.Val[int] {
+			return result.ValErrorf[int]("Expected error")
+		},
+	)
+	assert.EqualError(t, v, "Expected error")
+	assert.True(t, <-canceled)
+}
+
+func TestParallelCollect(t *testing.T) {
+	vs := result.ParallelCollect(context.Background(),
+		func(context.Context) result.Val[int] {
+			time.Sleep(10 * time.Millisecond)
+			return result.NewVal(1)
+		},
+		func(context.Context) result.Val[int] {
+			return result.ValErrorf[int]("Expected error")
+		},
+		func(context.Context) result.Val[int] {
+			panic("Expected panic")
+		},
+	)
+	assert.Equal(t, 1, vs[0].OrPanic("Unexpected error"))
+	assert.EqualError(t, vs[1], "Expected error")
+	assert.EqualError(t, vs[2], "panic: Expected panic")
+}
@@ -0,0 +1,70 @@
+package result
+
+// Recover calls f and returns its value as an ok Val. If f panics, the panic is recovered and an error Val is returned
+// instead. Use it to call functions that panic rather than returning an error. Usage:
+//     tree := result.Recover(func() *Tree {
+//         return parser.MustParse(src)
+//     }).
+//         OrError("Couldn't parse source")
+// Panics from OrError and OrDoAndReturn aren't recovered; they're passed on to the calling function's handler
+func Recover[T any](f func() T) (res Val[T]) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		switch r.(type) {
+		case panicToError, panicToReturn:
+			panic(r)
+		}
+		res = ValError[T](panicError(r))
+	}()
+	return NewVal(f())
+}
+
+// Recover2 converts panicVal, a value from recover, into an error Vals. If panicVal is nil, there was no panic, and an
+// ok Vals with zero values is returned. Usage:
+//     func f() (res result.Vals[int, string]) {
+//         defer func() {
+//             if r := recover(); r != nil {
+//                 res = result.Recover2[int, string](r)
+//             }
+//         }()
+//         // ...
+//     }
+func Recover2[T, U any](panicVal any) Vals[T, U] {
+	if panicVal == nil {
+		return Vals[T, U]{}
+	}
+	return ValsError[T, U](panicError(panicVal))
+}
+
+// RecoverStatus converts panicVal, a value from recover, into an error Status. If panicVal is nil, there was no panic,
+// and an ok Status is returned
+func RecoverStatus(panicVal any) Status {
+	if panicVal == nil {
+		return Ok()
+	}
+	return Error(panicError(panicVal))
+}
+
+// RecoverHandled is the building block for custom handlers. It returns whether panicVal, a value from recover, came
+// from this package. If it came from OrError, its error is also set on res. If it came from OrDoAndReturn, res is left
+// unchanged. Any other panic should usually be passed on with panic(panicVal). Usage:
+//     func myHandle(res *result.Status) {
+//         r := recover()
+//         if r == nil || result.RecoverHandled(r, res) {
+//             return
+//         }
+//         panic(r)
+//     }
+func RecoverHandled(panicVal any, res errorSetter) bool {
+	switch p := panicVal.(type) {
+	case panicToError:
+		res.setError(p.err)
+		return true
+	case panicToReturn:
+		return true
+	}
+	return false
+}
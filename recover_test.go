@@ -0,0 +1,94 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func recover2() (res result.Vals[int, string]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = result.Recover2[int, string](r)
+		}
+	}()
+	panic("Expected panic")
+}
+
+func TestRecover2(t *testing.T) {
+	assert.EqualError(t, recover2(), "panic: Expected panic")
+	a, b := result.Recover2[int, string](nil).OrPanic("Unexpected error")
+	assert.Equal(t, 0, a)
+	assert.Equal(t, "", b)
+}
+
+func TestRecoverStatus(t *testing.T) {
+	assert.EqualError(t, result.RecoverStatus(errors.New("Expected error")), "panic: Expected error")
+	assert.True(t, result.RecoverStatus(nil).Ok())
+}
+
+// customHandle is a handler built with RecoverHandled
+func customHandle(res *result.Status) {
+	r := recover()
+	if r == nil || result.RecoverHandled(r, res) {
+		return
+	}
+	panic(r)
+}
+
+func recoverHandledOrError() (res result.Status) {
+	defer customHandle(&res)
+	result.Errorf("Expected error").
+		OrError("Context")
+	return result.Ok()
+}
+
+func recoverHandledOrDoAndReturn() (res result.Status) {
+	defer customHandle(&res)
+	res = result.Errorf("Set before return")
+	result.Errorf("Expected error").
+		OrDoAndReturn(func(error) {})
+	return result.Ok()
+}
+
+func recoverHandledPanic() (res result.Status) {
+	defer customHandle(&res)
+	panic("Expected panic")
+}
+
+func TestRecoverHandled(t *testing.T) {
+	assert.EqualError(t, recoverHandledOrError(), "Context: Expected error")
+	assert.EqualError(t, recoverHandledOrDoAndReturn(), "Set before return")
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		recoverHandledPanic()
+	})
+}
+
+func TestRecover(t *testing.T) {
+	assert.Equal(t, 1, result.Recover(func() int {
+		return 1
+	}).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Recover(func() int {
+		panic("Expected panic")
+	}), "panic: Expected panic")
+	err := errors.New("Expected error")
+	v := result.Recover(func() int {
+		panic(err)
+	})
+	assert.EqualError(t, v, "panic: Expected error")
+	assert.True(t, errors.Is(v, err))
+}
+
+func recoverOrError() (res result.Status) {
+	defer result.Handle(&res)
+	result.Recover(func() int {
+		return result.ValErrorf[int]("Expected error").OrError("Context")
+	})
+	return result.Errorf("Recover caught OrError's panic")
+}
+
+func TestRecoverPassesOnOrError(t *testing.T) {
+	assert.EqualError(t, recoverOrError(), "Context: Expected error")
+}
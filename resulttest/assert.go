@@ -0,0 +1,164 @@
+package resulttest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bmheenan/result"
+)
+
+// RequireOk returns the value of v if it's ok. Otherwise, it fails t with v's error and stops the test. Usage:
+//     u := resulttest.RequireOk(t, parseUser(data))
+func RequireOk[T any](t testing.TB, v result.Val[T]) T {
+	t.Helper()
+	if !v.Ok() {
+		t.Fatalf("Expected ok result, got error: %v", v.Error())
+	}
+	var zero T
+	return v.OrUse(zero)
+}
+
+// AssertOk returns the value of v and true if it's ok. Otherwise, it fails t with v's error, lets the test continue,
+// and returns the zero value of T and false
+func AssertOk[T any](t testing.TB, v result.Val[T]) (T, bool) {
+	t.Helper()
+	var zero T
+	if !v.Ok() {
+		t.Errorf("Expected ok result, got error: %v", v.Error())
+		return zero, false
+	}
+	return v.OrUse(zero), true
+}
+
+// RequireErr returns the error of v if it's an error. Otherwise, it fails t with v's value and stops the test
+func RequireErr[T any](t testing.TB, v result.Val[T]) error {
+	t.Helper()
+	if v.Ok() {
+		var zero T
+		t.Fatalf("Expected error, got ok result with value %v", v.OrUse(zero))
+	}
+	return v.Err()
+}
+
+// AssertErr returns whether v is an error. If it isn't, it fails t with v's value, and lets the test continue
+func AssertErr[T any](t testing.TB, v result.Val[T]) bool {
+	t.Helper()
+	if v.Ok() {
+		var zero T
+		t.Errorf("Expected error, got ok result with value %v", v.OrUse(zero))
+		return false
+	}
+	return true
+}
+
+// RequireOkStatus fails t with s's error and stops the test if s is an error
+func RequireOkStatus(t testing.TB, s result.Status) {
+	t.Helper()
+	if !s.Ok() {
+		t.Fatalf("Expected ok result, got error: %v", s.Error())
+	}
+}
+
+// AssertOkStatus returns whether s is ok. If it isn't, it fails t with s's error, and lets the test continue
+func AssertOkStatus(t testing.TB, s result.Status) bool {
+	t.Helper()
+	if !s.Ok() {
+		t.Errorf("Expected ok result, got error: %v", s.Error())
+		return false
+	}
+	return true
+}
+
+// RequireErrStatus returns the error of s if it's an error. Otherwise, it fails t and stops the test
+func RequireErrStatus(t testing.TB, s result.Status) error {
+	t.Helper()
+	if s.Ok() {
+		t.Fatalf("Expected error, got ok result")
+	}
+	return s.Err()
+}
+
+// AssertErrStatus returns whether s is an error. If it isn't, it fails t, and lets the test continue
+func AssertErrStatus(t testing.TB, s result.Status) bool {
+	t.Helper()
+	if s.Ok() {
+		t.Errorf("Expected error, got ok result")
+		return false
+	}
+	return true
+}
+
+// RequireOkVals returns the values of v if it's ok. Otherwise, it fails t with v's error and stops the test. Usage:
+//     first, last := resulttest.RequireOkVals(t, splitName("Ada Lovelace"))
+func RequireOkVals[T, U any](t testing.TB, v result.Vals[T, U]) (T, U) {
+	t.Helper()
+	if !v.Ok() {
+		t.Fatalf("Expected ok result, got error: %v", v.Error())
+	}
+	return okVals(v)
+}
+
+// AssertOkVals returns the values of v and true if it's ok. Otherwise, it fails t with v's error, lets the test
+// continue, and returns the zero values of T and U and false
+func AssertOkVals[T, U any](t testing.TB, v result.Vals[T, U]) (T, U, bool) {
+	t.Helper()
+	if !v.Ok() {
+		t.Errorf("Expected ok result, got error: %v", v.Error())
+	}
+	v0, v1 := okVals(v)
+	return v0, v1, v.Ok()
+}
+
+// RequireErrVals returns the error of v if it's an error. Otherwise, it fails t with v's values and stops the test
+func RequireErrVals[T, U any](t testing.TB, v result.Vals[T, U]) error {
+	t.Helper()
+	if v.Ok() {
+		v0, v1 := okVals(v)
+		t.Fatalf("Expected error, got ok result with values %v, %v", v0, v1)
+	}
+	return v.Err()
+}
+
+// AssertErrVals returns whether v is an error. If it isn't, it fails t with v's values, and lets the test continue
+func AssertErrVals[T, U any](t testing.TB, v result.Vals[T, U]) bool {
+	t.Helper()
+	if v.Ok() {
+		v0, v1 := okVals(v)
+		t.Errorf("Expected error, got ok result with values %v, %v", v0, v1)
+		return false
+	}
+	return true
+}
+
+// okVals returns the values of v, or zero values if v is an error
+func okVals[T, U any](v result.Vals[T, U]) (T, U) {
+	var zero0 T
+	var zero1 U
+	return v.OrUse(zero0, zero1)
+}
+
+// AssertEqualVals returns whether want and got are equal: both ok with equal values, or both errors with equal
+// messages. If they aren't, it fails t with both results, and lets the test continue. Usage:
+//     resulttest.AssertEqualVals(t, result.NewVal(42), parseAge("42"))
+func AssertEqualVals[T comparable](t testing.TB, want, got result.Val[T]) bool {
+	t.Helper()
+	var zero T
+	switch {
+	case want.Ok() != got.Ok():
+	case want.Ok() && want.OrUse(zero) == got.OrUse(zero):
+		return true
+	case !want.Ok() && want.Error() == got.Error():
+		return true
+	}
+	t.Errorf("Results aren't equal\n- want: %v\n+ got:  %v", describe(want), describe(got))
+	return false
+}
+
+// describe returns a description of v for failure messages
+func describe[T any](v result.Val[T]) string {
+	if !v.Ok() {
+		return fmt.Sprintf("error %q", v.Error())
+	}
+	var zero T
+	return fmt.Sprintf("ok result with value %v", v.OrUse(zero))
+}
@@ -0,0 +1,129 @@
+package resulttest
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+)
+
+// expectFailure checks that f recorded exactly the failure expected, and was or wasn't stopped as expected
+func expectFailure(t *testing.T, f *fakeTB, stopped, expectStopped bool, expected string) {
+	t.Helper()
+	if len(f.failures) != 1 || f.failures[0] != expected {
+		t.Errorf("Expected failure %q, got %q", expected, f.failures)
+	}
+	if stopped != expectStopped {
+		t.Errorf("Expected stopped to be %v, got %v", expectStopped, stopped)
+	}
+}
+
+func TestRequireOkFailure(t *testing.T) {
+	f := &fakeTB{}
+	stopped := f.stopped(func(tb testing.TB) {
+		RequireOk(tb, result.ValErrorf[int]("Oops"))
+	})
+	expectFailure(t, f, stopped, true, "Expected ok result, got error: Oops")
+}
+
+func TestAssertOkFailure(t *testing.T) {
+	f := &fakeTB{}
+	var ok bool
+	stopped := f.stopped(func(tb testing.TB) {
+		_, ok = AssertOk(tb, result.ValErrorf[int]("Oops"))
+	})
+	expectFailure(t, f, stopped, false, "Expected ok result, got error: Oops")
+	if ok {
+		t.Error("Expected AssertOk to return false")
+	}
+}
+
+func TestRequireErrFailure(t *testing.T) {
+	f := &fakeTB{}
+	stopped := f.stopped(func(tb testing.TB) {
+		RequireErr(tb, result.NewVal(1))
+	})
+	expectFailure(t, f, stopped, true, "Expected error, got ok result with value 1")
+}
+
+func TestAssertErrFailure(t *testing.T) {
+	f := &fakeTB{}
+	stopped := f.stopped(func(tb testing.TB) {
+		AssertErr(tb, result.NewVal(1))
+	})
+	expectFailure(t, f, stopped, false, "Expected error, got ok result with value 1")
+}
+
+func TestStatusAssertionFailures(t *testing.T) {
+	f := &fakeTB{}
+	stopped := f.stopped(func(tb testing.TB) {
+		RequireOkStatus(tb, result.Errorf("Oops"))
+	})
+	expectFailure(t, f, stopped, true, "Expected ok result, got error: Oops")
+
+	f = &fakeTB{}
+	stopped = f.stopped(func(tb testing.TB) {
+		AssertOkStatus(tb, result.Errorf("Oops"))
+	})
+	expectFailure(t, f, stopped, false, "Expected ok result, got error: Oops")
+
+	f = &fakeTB{}
+	stopped = f.stopped(func(tb testing.TB) {
+		RequireErrStatus(tb, result.Ok())
+	})
+	expectFailure(t, f, stopped, true, "Expected error, got ok result")
+
+	f = &fakeTB{}
+	stopped = f.stopped(func(tb testing.TB) {
+		AssertErrStatus(tb, result.Ok())
+	})
+	expectFailure(t, f, stopped, false, "Expected error, got ok result")
+}
+
+func TestValsAssertionFailures(t *testing.T) {
+	f := &fakeTB{}
+	stopped := f.stopped(func(tb testing.TB) {
+		RequireOkVals(tb, result.ValsErrorf[int, string]("Oops"))
+	})
+	expectFailure(t, f, stopped, true, "Expected ok result, got error: Oops")
+
+	f = &fakeTB{}
+	var ok bool
+	stopped = f.stopped(func(tb testing.TB) {
+		_, _, ok = AssertOkVals(tb, result.ValsErrorf[int, string]("Oops"))
+	})
+	expectFailure(t, f, stopped, false, "Expected ok result, got error: Oops")
+	if ok {
+		t.Error("Expected AssertOkVals to return false")
+	}
+
+	f = &fakeTB{}
+	stopped = f.stopped(func(tb testing.TB) {
+		RequireErrVals(tb, result.NewVals(1, "a"))
+	})
+	expectFailure(t, f, stopped, true, "Expected error, got ok result with values 1, a")
+
+	f = &fakeTB{}
+	stopped = f.stopped(func(tb testing.TB) {
+		AssertErrVals(tb, result.NewVals(1, "a"))
+	})
+	expectFailure(t, f, stopped, false, "Expected error, got ok result with values 1, a")
+}
+
+func TestAssertEqualValsFailures(t *testing.T) {
+	cases := []struct {
+		want, got result.Val[int]
+		expected  string
+	}{
+		{result.NewVal(1), result.NewVal(2), "- want: ok result with value 1\n+ got:  ok result with value 2"},
+		{result.NewVal(1), result.ValErrorf[int]("Oops"), "- want: ok result with value 1\n+ got:  error \"Oops\""},
+		{result.ValErrorf[int]("Oops"), result.NewVal(0), "- want: error \"Oops\"\n+ got:  ok result with value 0"},
+		{result.ValErrorf[int]("Oops"), result.ValErrorf[int]("Other"), "- want: error \"Oops\"\n+ got:  error \"Other\""},
+	}
+	for _, c := range cases {
+		f := &fakeTB{}
+		if AssertEqualVals(f, c.want, c.got) {
+			t.Error("Expected AssertEqualVals to return false")
+		}
+		expectFailure(t, f, false, false, "Results aren't equal\n"+c.expected)
+	}
+}
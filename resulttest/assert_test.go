@@ -0,0 +1,64 @@
+package resulttest_test
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/bmheenan/result/resulttest"
+)
+
+func TestRequireOk(t *testing.T) {
+	if g := resulttest.RequireOk(t, result.NewVal(1)); g != 1 {
+		t.Errorf("Expected 1, got %v", g)
+	}
+	if g, ok := resulttest.AssertOk(t, result.NewVal(1)); g != 1 || !ok {
+		t.Errorf("Expected 1 and true, got %v and %v", g, ok)
+	}
+}
+
+func TestRequireErr(t *testing.T) {
+	if err := resulttest.RequireErr(t, result.ValErrorf[int]("Expected error")); err.Error() != "Expected error" {
+		t.Errorf("Expected error 'Expected error', got %q", err)
+	}
+	if !resulttest.AssertErr(t, result.ValErrorf[int]("Expected error")) {
+		t.Error("Expected AssertErr to return true")
+	}
+}
+
+func TestStatusAssertions(t *testing.T) {
+	resulttest.RequireOkStatus(t, result.Ok())
+	if !resulttest.AssertOkStatus(t, result.Ok()) {
+		t.Error("Expected AssertOkStatus to return true")
+	}
+	if err := resulttest.RequireErrStatus(t, result.Errorf("Expected error")); err.Error() != "Expected error" {
+		t.Errorf("Expected error 'Expected error', got %q", err)
+	}
+	if !resulttest.AssertErrStatus(t, result.Errorf("Expected error")) {
+		t.Error("Expected AssertErrStatus to return true")
+	}
+}
+
+func TestValsAssertions(t *testing.T) {
+	if i, s := resulttest.RequireOkVals(t, result.NewVals(1, "a")); i != 1 || s != "a" {
+		t.Errorf("Expected 1 and a, got %v and %v", i, s)
+	}
+	if i, s, ok := resulttest.AssertOkVals(t, result.NewVals(1, "a")); i != 1 || s != "a" || !ok {
+		t.Errorf("Expected 1, a and true, got %v, %v and %v", i, s, ok)
+	}
+	err := resulttest.RequireErrVals(t, result.ValsErrorf[int, string]("Expected error"))
+	if err.Error() != "Expected error" {
+		t.Errorf("Expected error 'Expected error', got %q", err)
+	}
+	if !resulttest.AssertErrVals(t, result.ValsErrorf[int, string]("Expected error")) {
+		t.Error("Expected AssertErrVals to return true")
+	}
+}
+
+func TestAssertEqualVals(t *testing.T) {
+	if !resulttest.AssertEqualVals(t, result.NewVal(1), result.NewVal(1)) {
+		t.Error("Expected equal ok results")
+	}
+	if !resulttest.AssertEqualVals(t, result.ValErrorf[int]("Expected error"), result.ValErrorf[int]("Expected error")) {
+		t.Error("Expected equal error results")
+	}
+}
@@ -0,0 +1,42 @@
+package resulttest
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+)
+
+// FuzzVal fuzzes exercise with Vals. The seed corpus has an ok Val for each of seeds, and an error Val for each of
+// errSeeds. The fuzzer then generates more, using the JSON encoding of T, so T must be a type that encoding/json can
+// encode and decode. exercise should fail the test by panicking, e.g. with OrPanic. Usage:
+//     func FuzzDouble(f *testing.F) {
+//         resulttest.FuzzVal(f, []int{0, 1, -1}, []string{"Expected error"}, func(v result.Val[int]) {
+//             double(v) // must not panic for any input
+//         })
+//     }
+func FuzzVal[T any](f *testing.F, seeds []T, errSeeds []string, exercise func(result.Val[T])) {
+	f.Helper()
+	for _, s := range seeds {
+		b, err := json.Marshal(s)
+		if err != nil {
+			f.Fatalf("Couldn't encode seed %v: %v", s, err)
+		}
+		f.Add(false, b)
+	}
+	for _, s := range errSeeds {
+		f.Add(true, []byte(s))
+	}
+	f.Fuzz(func(t *testing.T, isErr bool, data []byte) {
+		if isErr {
+			exercise(result.ValError[T](errors.New(string(data))))
+			return
+		}
+		var v T
+		if err := json.Unmarshal(data, &v); err != nil {
+			t.Skip("Input isn't a valid encoding of the value type")
+		}
+		exercise(result.NewVal(v))
+	})
+}
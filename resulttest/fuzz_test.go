@@ -0,0 +1,29 @@
+package resulttest_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/bmheenan/result/resulttest"
+)
+
+func itoa(v result.Val[int]) (res result.Val[string]) {
+	defer result.Handle(&res)
+	i := v.OrError("No int")
+	return result.NewVal(strconv.Itoa(i))
+}
+
+func FuzzItoa(f *testing.F) {
+	resulttest.FuzzVal(f, []int{0, 1, -1}, []string{"Expected error", ""}, func(v result.Val[int]) {
+		s := itoa(v)
+		if v.Ok() != s.Ok() {
+			panic("itoa changed whether the result was ok")
+		}
+		if v.Ok() {
+			if i, err := strconv.Atoi(s.OrPanic("Unexpected error")); err != nil || i != v.OrPanic("Unexpected error") {
+				panic("itoa didn't round trip")
+			}
+		}
+	})
+}
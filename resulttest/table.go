@@ -0,0 +1,103 @@
+// Package resulttest provides helpers for testing code that returns results
+package resulttest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bmheenan/result"
+)
+
+// Case is a table-driven test case for a Val. If ExpectedErr is empty, Input must be ok with a value equal to
+// Expected. Otherwise, Input must be an error with a message equal to ExpectedErr. Name is used as the sub-test name;
+// if it's empty, the case's index is used
+type Case[T comparable] struct {
+	Name        string
+	Input       result.Val[T]
+	Expected    T
+	ExpectedErr string
+}
+
+// StatusCase is a table-driven test case for a Status. If ExpectedErr is empty, Input must be ok. Otherwise, Input must
+// be an error with a message equal to ExpectedErr
+type StatusCase struct {
+	Name        string
+	Input       result.Status
+	ExpectedErr string
+}
+
+// TableDriven runs each of cases as a sub-test of t. Usage:
+//     resulttest.TableDriven([]resulttest.Case[int]{
+//         {Name: "valid", Input: parseAge("42"), Expected: 42},
+//         {Name: "negative", Input: parseAge("-1"), ExpectedErr: "age must be positive"},
+//     }, t)
+func TableDriven[T comparable](cases []Case[T], t *testing.T) {
+	t.Helper()
+	for i, c := range cases {
+		c := c
+		t.Run(caseName(c.Name, i), func(t *testing.T) {
+			t.Helper()
+			checkVal(t, c)
+		})
+	}
+}
+
+// TableDrivenStatus is like TableDriven, for Statuses
+func TableDrivenStatus(cases []StatusCase, t *testing.T) {
+	t.Helper()
+	for i, c := range cases {
+		c := c
+		t.Run(caseName(c.Name, i), func(t *testing.T) {
+			t.Helper()
+			checkStatus(t, c)
+		})
+	}
+}
+
+func checkVal[T comparable](t testing.TB, c Case[T]) {
+	t.Helper()
+	if c.ExpectedErr == "" {
+		if !c.Input.Ok() {
+			t.Errorf("Expected ok result with value %v, got error: %v", c.Expected, c.Input.Error())
+			return
+		}
+		var zero T
+		if g := c.Input.OrUse(zero); g != c.Expected {
+			t.Errorf("Expected value %v, got %v", c.Expected, g)
+		}
+		return
+	}
+	if c.Input.Ok() {
+		var zero T
+		t.Errorf("Expected error %q, got ok result with value %v", c.ExpectedErr, c.Input.OrUse(zero))
+		return
+	}
+	if g := c.Input.Error(); g != c.ExpectedErr {
+		t.Errorf("Expected error %q, got %q", c.ExpectedErr, g)
+	}
+}
+
+func checkStatus(t testing.TB, c StatusCase) {
+	t.Helper()
+	if c.ExpectedErr == "" {
+		if !c.Input.Ok() {
+			t.Errorf("Expected ok result, got error: %v", c.Input.Error())
+		}
+		return
+	}
+	if c.Input.Ok() {
+		t.Errorf("Expected error %q, got ok result", c.ExpectedErr)
+		return
+	}
+	if g := c.Input.Error(); g != c.ExpectedErr {
+		t.Errorf("Expected error %q, got %q", c.ExpectedErr, g)
+	}
+}
+
+// caseName returns name, or a name made from i if name is empty
+func caseName(name string, i int) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("case_%v", i)
+}
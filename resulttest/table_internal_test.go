@@ -0,0 +1,88 @@
+package resulttest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bmheenan/result"
+)
+
+// fakeTB records failures instead of failing the test
+type fakeTB struct {
+	testing.TB
+	failures []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.failures = append(f.failures, fmt.Sprintf(format, args...))
+}
+
+// fatal is the panic fakeTB uses to stop the calling function, like testing.TB.FailNow
+type fatal struct{}
+
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.Errorf(format, args...)
+	panic(fatal{})
+}
+
+// stopped calls fn with f, and returns whether fn was stopped by Fatalf
+func (f *fakeTB) stopped(fn func(testing.TB)) (stopped bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(fatal); !ok {
+				panic(r)
+			}
+			stopped = true
+		}
+	}()
+	fn(f)
+	return false
+}
+
+func TestCheckValFailures(t *testing.T) {
+	cases := []struct {
+		c        Case[int]
+		expected string
+	}{
+		{Case[int]{Input: result.NewVal(1), Expected: 2}, "Expected value 2, got 1"},
+		{Case[int]{Input: result.ValErrorf[int]("Oops"), Expected: 2}, "Expected ok result with value 2, got error: Oops"},
+		{Case[int]{Input: result.NewVal(1), ExpectedErr: "Oops"}, `Expected error "Oops", got ok result with value 1`},
+		{Case[int]{Input: result.ValErrorf[int]("Other"), ExpectedErr: "Oops"}, `Expected error "Oops", got "Other"`},
+	}
+	for _, c := range cases {
+		f := &fakeTB{}
+		checkVal(f, c.c)
+		if len(f.failures) != 1 || f.failures[0] != c.expected {
+			t.Errorf("Expected failure %q, got %q", c.expected, f.failures)
+		}
+	}
+}
+
+func TestCheckStatusFailures(t *testing.T) {
+	cases := []struct {
+		c        StatusCase
+		expected string
+	}{
+		{StatusCase{Input: result.Errorf("Oops")}, "Expected ok result, got error: Oops"},
+		{StatusCase{Input: result.Ok(), ExpectedErr: "Oops"}, `Expected error "Oops", got ok result`},
+		{StatusCase{Input: result.Errorf("Other"), ExpectedErr: "Oops"}, `Expected error "Oops", got "Other"`},
+	}
+	for _, c := range cases {
+		f := &fakeTB{}
+		checkStatus(f, c.c)
+		if len(f.failures) != 1 || f.failures[0] != c.expected {
+			t.Errorf("Expected failure %q, got %q", c.expected, f.failures)
+		}
+	}
+}
+
+func TestCaseName(t *testing.T) {
+	if g := caseName("named", 1); g != "named" {
+		t.Errorf("Expected name 'named', got %q", g)
+	}
+	if g := caseName("", 1); g != "case_1" {
+		t.Errorf("Expected name 'case_1', got %q", g)
+	}
+}
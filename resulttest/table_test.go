@@ -0,0 +1,24 @@
+package resulttest_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/bmheenan/result/resulttest"
+)
+
+func TestTableDriven(t *testing.T) {
+	resulttest.TableDriven([]resulttest.Case[int]{
+		{Name: "valid", Input: result.TryVal(strconv.Atoi("42")), Expected: 42},
+		{Name: "invalid", Input: result.TryVal(strconv.Atoi("x")), ExpectedErr: `strconv.Atoi: parsing "x": invalid syntax`},
+		{Input: result.NewVal(0)},
+	}, t)
+}
+
+func TestTableDrivenStatus(t *testing.T) {
+	resulttest.TableDrivenStatus([]resulttest.StatusCase{
+		{Name: "ok", Input: result.Ok()},
+		{Name: "error", Input: result.Errorf("Expected error"), ExpectedErr: "Expected error"},
+	}, t)
+}
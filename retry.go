@@ -0,0 +1,108 @@
+package result
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WindowedRetry returns a function that calls f, unless f has already failed maxFailures times within the last window.
+// In that case it returns an error Val without calling f, until enough of those failures are older than window. Unlike
+// CircuitBreaker, there's no open or closed state; only the count of recent failures matters. It returns a function
+// rather than a Val, because the count of recent failures has to be kept between calls, and a Val holds a single
+// result. The returned function is safe for concurrent use: calls to f that are still running count toward
+// maxFailures, so concurrent callers can't call f more than maxFailures times between them. Usage:
+//     fetch := result.WindowedRetry(time.Minute, 5, fetchPrices)
+//     prices := fetch().
+//         OrError("Couldn't fetch prices")
+func WindowedRetry[T any](window time.Duration, maxFailures int, f func() Val[T]) func() Val[T] {
+	if maxFailures <= 0 {
+		return func() Val[T] {
+			return ValErrorf[T]("Max failures must be positive, got %v", maxFailures)
+		}
+	}
+	var mu sync.Mutex
+	// failures is a ring buffer of the times of the last maxFailures failures. next is the position of the oldest
+	failures := make([]time.Time, 0, maxFailures)
+	next := 0
+	// inFlight is the number of calls to f that haven't returned yet. Each might fail, so each holds a slot toward
+	// maxFailures until it succeeds
+	inFlight := 0
+	return func() Val[T] {
+		mu.Lock()
+		recent := 0
+		for _, t := range failures {
+			if time.Since(t) < window {
+				recent++
+			}
+		}
+		if recent == maxFailures {
+			mu.Unlock()
+			return ValErrorf[T]("%v failures within the last %v", maxFailures, window)
+		}
+		if running := inFlight; recent+running >= maxFailures {
+			mu.Unlock()
+			return ValErrorf[T]("%v failures within the last %v, and %v calls in progress", recent, window, running)
+		}
+		inFlight++
+		mu.Unlock()
+		v := f()
+		mu.Lock()
+		defer mu.Unlock()
+		inFlight--
+		if v.err == nil {
+			return v
+		}
+		if len(failures) < maxFailures {
+			failures = append(failures, time.Now())
+			return v
+		}
+		failures[next] = time.Now()
+		next = (next + 1) % maxFailures
+		return v
+	}
+}
+
+// Retry calls f up to n times, and returns its first ok result. If every attempt fails, it returns the error result of
+// the last attempt. Usage:
+//     conn := result.Retry(3, dial).
+//         OrError("Couldn't connect")
+func Retry[T any](n int, f func() Val[T]) Val[T] {
+	if n <= 0 {
+		return ValErrorf[T]("Retry attempts must be positive, got %v", n)
+	}
+	v := f()
+	for i := 1; i < n && v.err != nil; i++ {
+		v = f()
+	}
+	return v
+}
+
+// RetryWithBackoff is like Retry, but waits backoff(attempt) between attempts, where attempt is the number of attempts
+// that have failed so far. If ctx is done first, it stops waiting and returns an error wrapping ctx.Err(). Usage:
+//     conn := result.RetryWithBackoff(ctx, 5, func(attempt int) time.Duration {
+//         return time.Duration(attempt) * 100 * time.Millisecond
+//     }, dial).
+//         OrError("Couldn't connect")
+func RetryWithBackoff[T any](
+	ctx context.Context,
+	n int,
+	backoff func(attempt int) time.Duration,
+	f func() Val[T],
+) Val[T] {
+	if n <= 0 {
+		return ValErrorf[T]("Retry attempts must be positive, got %v", n)
+	}
+	v := f()
+	for attempt := 1; attempt < n && v.err != nil; attempt++ {
+		t := time.NewTimer(backoff(attempt))
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ValErrorf[T]("Stopped retrying after %v attempts: %w", attempt, ctx.Err())
+		}
+		v = f()
+	}
+	return v
+}
@@ -0,0 +1,134 @@
+package result_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowedRetryUnderThreshold(t *testing.T) {
+	calls := 0
+	f := result.WindowedRetry(time.Minute, 3, func() result.Val[int] {
+		calls++
+		if calls <= 2 {
+			return result.ValErrorf[int]("Expected error")
+		}
+		return result.NewVal(calls)
+	})
+	f()
+	f()
+	assert.Equal(t, 3, f().OrPanic("Unexpected error"))
+	assert.Equal(t, 3, calls)
+}
+
+func TestWindowedRetryOverThreshold(t *testing.T) {
+	calls := 0
+	f := result.WindowedRetry(time.Minute, 2, func() result.Val[int] {
+		calls++
+		return result.ValErrorf[int]("Expected error")
+	})
+	assert.EqualError(t, f(), "Expected error")
+	assert.EqualError(t, f(), "Expected error")
+	assert.EqualError(t, f(), "2 failures within the last 1m0s")
+	assert.Equal(t, 2, calls)
+}
+
+func TestWindowedRetryWindowExpires(t *testing.T) {
+	calls := 0
+	f := result.WindowedRetry(20*time.Millisecond, 1, func() result.Val[int] {
+		calls++
+		return result.ValErrorf[int]("Expected error")
+	})
+	f()
+	f()
+	assert.Equal(t, 1, calls)
+	time.Sleep(30 * time.Millisecond)
+	f()
+	assert.Equal(t, 2, calls)
+}
+
+func TestWindowedRetryConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	f := result.WindowedRetry(time.Minute, 5, func() result.Val[int] {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		return result.ValErrorf[int]("Expected error")
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, calls, 5)
+	assert.EqualError(t, f(), "5 failures within the last 1m0s")
+}
+
+func TestWindowedRetryInvalidMax(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.WindowedRetry(time.Minute, 0, func() result.Val[int] {
+			t.Error("f called with invalid max failures")
+			return result.NewVal(0)
+		})(),
+		"Max failures must be positive, got 0",
+	)
+}
+
+func failUntil[T any](n int, v T) (func() result.Val[T], *int) {
+	calls := 0
+	return func() result.Val[T] {
+		calls++
+		if calls < n {
+			return result.ValErrorf[T]("Expected error %v", calls)
+		}
+		return result.NewVal(v)
+	}, &calls
+}
+
+func TestRetry(t *testing.T) {
+	f, calls := failUntil(3, "ok")
+	assert.Equal(t, "ok", result.Retry(3, f).OrPanic("Unexpected error"))
+	assert.Equal(t, 3, *calls)
+
+	f, calls = failUntil(5, "ok")
+	assert.EqualError(t, result.Retry(3, f), "Expected error 3")
+	assert.Equal(t, 3, *calls)
+
+	f, calls = failUntil(1, "ok")
+	assert.EqualError(t, result.Retry(0, f), "Retry attempts must be positive, got 0")
+	assert.Equal(t, 0, *calls)
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	attempts := []int{}
+	backoff := func(attempt int) time.Duration {
+		attempts = append(attempts, attempt)
+		return time.Millisecond
+	}
+	f, calls := failUntil(3, "ok")
+	assert.Equal(t, "ok", result.RetryWithBackoff(context.Background(), 5, backoff, f).OrPanic("Unexpected error"))
+	assert.Equal(t, 3, *calls)
+	assert.Equal(t, []int{1, 2}, attempts)
+}
+
+func TestRetryWithBackoffCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f, calls := failUntil(3, "ok")
+	v := result.RetryWithBackoff(ctx, 5, func(int) time.Duration {
+		return time.Hour
+	}, f)
+	assert.EqualError(t, v, "Stopped retrying after 1 attempts: context canceled")
+	assert.Equal(t, 1, *calls)
+}
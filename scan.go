@@ -0,0 +1,184 @@
+package result
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Scanner is a source of values that are read one at a time, like bufio.Scanner. Scan advances to the next value and
+// returns false when there are no more values or an error occurred. Value returns the current value. Err returns the
+// error that stopped scanning, or nil if the end of the values was reached
+type Scanner[T any] interface {
+	Scan() bool
+	Value() T
+	Err() error
+}
+
+// ScanVal reads the next value from scanner. If there are no more values, it returns an error Val wrapping io.EOF. If
+// scanner failed, it returns an error Val with scanner's error. Usage:
+//     header := result.ScanVal(s).
+//         OrError("Couldn't read header")
+func ScanVal[T any](scanner Scanner[T]) Val[T] {
+	if scanner.Scan() {
+		return NewVal(scanner.Value())
+	}
+	if err := scanner.Err(); err != nil {
+		return ValError[T](err)
+	}
+	return ValError[T](fmt.Errorf("Scanner had no more values: %w", io.EOF))
+}
+
+// ScanAll reads all remaining values from scanner. If scanner fails, ScanAll returns an error Val with scanner's error
+func ScanAll[T any](scanner Scanner[T]) Val[[]T] {
+	return ScanUntil(scanner, func(T) bool {
+		return true
+	})
+}
+
+// ScanUntil reads values from scanner until pred returns false, or there are no more values. The value pred returns
+// false for is consumed: it has already been read from scanner, isn't included in the returned values, and can't be
+// read again, so the next read from scanner gets the value after it. If scanner fails, ScanUntil returns an error Val
+// with scanner's error
+func ScanUntil[T any](scanner Scanner[T], pred func(T) bool) Val[[]T] {
+	vs := []T{}
+	for scanner.Scan() {
+		v := scanner.Value()
+		if !pred(v) {
+			return NewVal(vs)
+		}
+		vs = append(vs, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return ValError[[]T](err)
+	}
+	return NewVal(vs)
+}
+
+// Lines returns a Scanner of the tokens from s; the lines of its input unless s has been given a different split
+// function. Usage:
+//     lines := result.ScanAll(result.Lines(bufio.NewScanner(f))).
+//         OrError("Couldn't read file")
+func Lines(s *bufio.Scanner) Scanner[string] {
+	return lineScanner{s}
+}
+
+type lineScanner struct {
+	*bufio.Scanner
+}
+
+func (l lineScanner) Value() string {
+	return l.Text()
+}
+
+// RowScanner is the set of methods of *sql.Rows used by Rows. It lets Rows work with database rows without the result
+// package depending on database/sql
+type RowScanner interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+// Rows returns a Scanner that reads a value from each row of rows using scan. If scan fails, scanning stops and its
+// error is returned by Err. Usage:
+//     users := result.ScanAll(result.Rows(rows, func(r result.RowScanner) (User, error) {
+//         var u User
+//         err := r.Scan(&u.ID, &u.Name)
+//         return u, err
+//     })).
+//         OrError("Couldn't read users")
+func Rows[T any](rows RowScanner, scan func(RowScanner) (T, error)) Scanner[T] {
+	return &rowsScanner[T]{
+		rows: rows,
+		scan: scan,
+	}
+}
+
+type rowsScanner[T any] struct {
+	rows RowScanner
+	scan func(RowScanner) (T, error)
+	v    T
+	err  error
+}
+
+func (r *rowsScanner[T]) Scan() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+	v, err := r.scan(r.rows)
+	if err != nil {
+		r.err = err
+		return false
+	}
+	r.v = v
+	return true
+}
+
+func (r *rowsScanner[T]) Value() T {
+	return r.v
+}
+
+func (r *rowsScanner[T]) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// ResultScanner reads tokens from a reader like bufio.Scanner, and parses each one into a result
+type ResultScanner[T any] struct {
+	s     *bufio.Scanner
+	parse func([]byte) (T, error)
+	v     Val[T]
+}
+
+// ScannerOf returns a ResultScanner that splits r into tokens with split, and parses each token with parse. Usage:
+//     s := result.ScannerOf(r, bufio.ScanWords, func(b []byte) (int, error) {
+//         return strconv.Atoi(string(b))
+//     })
+//     for s.Next() {
+//         n := s.Value().OrUse(0)
+//         // ...
+//     }
+func ScannerOf[T any](r io.Reader, split bufio.SplitFunc, parse func([]byte) (T, error)) *ResultScanner[T] {
+	s := bufio.NewScanner(r)
+	s.Split(split)
+	return &ResultScanner[T]{
+		s:     s,
+		parse: parse,
+		v:     ValErrorf[T]("Next hasn't been called"),
+	}
+}
+
+// ScanLines returns a ResultScanner that parses each line of r with parse
+func ScanLines[T any](r io.Reader, parse func(string) (T, error)) *ResultScanner[T] {
+	return ScannerOf(r, bufio.ScanLines, func(b []byte) (T, error) {
+		return parse(string(b))
+	})
+}
+
+// Next advances to the next token, and returns false when there are no more tokens or the underlying scanner failed
+func (r *ResultScanner[T]) Next() bool {
+	if !r.s.Scan() {
+		if err := r.s.Err(); err != nil {
+			r.v = ValError[T](err)
+		} else {
+			r.v = ValError[T](fmt.Errorf("Scanner had no more values: %w", io.EOF))
+		}
+		return false
+	}
+	r.v = TryVal(r.parse(r.s.Bytes()))
+	return true
+}
+
+// Value returns the parsed result of the current token. It's an error Val if parsing the token failed, or if the
+// underlying scanner failed
+func (r *ResultScanner[T]) Value() Val[T] {
+	return r.v
+}
+
+// Err returns the first error from the underlying scanner, or nil if it reached the end of its input. Parsing errors
+// aren't included; they're only returned by Value
+func (r *ResultScanner[T]) Err() error {
+	return r.s.Err()
+}
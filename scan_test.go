@@ -0,0 +1,146 @@
+package result_test
+
+import (
+	"bufio"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// intScanner is a mock Scanner[int] that returns vs, then err
+type intScanner struct {
+	vs  []int
+	i   int
+	err error
+}
+
+func (s *intScanner) Scan() bool {
+	if s.i >= len(s.vs) {
+		return false
+	}
+	s.i++
+	return true
+}
+
+func (s *intScanner) Value() int {
+	return s.vs[s.i-1]
+}
+
+func (s *intScanner) Err() error {
+	if s.i >= len(s.vs) {
+		return s.err
+	}
+	return nil
+}
+
+func TestScanVal(t *testing.T) {
+	s := &intScanner{vs: []int{1}}
+	assert.Equal(t, 1, result.ScanVal[int](s).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.ScanVal[int](s), "Scanner had no more values: EOF")
+
+	s = &intScanner{err: errors.New("Expected error")}
+	assert.EqualError(t, result.ScanVal[int](s), "Expected error")
+}
+
+func TestScanAll(t *testing.T) {
+	s := &intScanner{vs: []int{1, 2, 3}}
+	assert.Equal(t, []int{1, 2, 3}, result.ScanAll[int](s).OrPanic("Unexpected error"))
+
+	s = &intScanner{vs: []int{1, 2}, err: errors.New("Expected error")}
+	assert.EqualError(t, result.ScanAll[int](s), "Expected error")
+}
+
+func TestScanUntil(t *testing.T) {
+	s := &intScanner{vs: []int{1, 2, 3, 4}}
+	vs := result.ScanUntil[int](s, func(i int) bool {
+		return i < 3
+	}).OrPanic("Unexpected error")
+	assert.Equal(t, []int{1, 2}, vs)
+	assert.Equal(t, 4, result.ScanVal[int](s).OrPanic("Unexpected error"))
+}
+
+func TestScanUntilConsumesRejected(t *testing.T) {
+	s := &intScanner{vs: []int{1, 2}}
+	vs := result.ScanUntil[int](s, func(i int) bool {
+		return i < 2
+	}).OrPanic("Unexpected error")
+	assert.Equal(t, []int{1}, vs)
+	assert.Equal(t, []int{}, result.ScanAll[int](s).OrPanic("Unexpected error"))
+}
+
+func TestLines(t *testing.T) {
+	lines := result.ScanAll(result.Lines(bufio.NewScanner(strings.NewReader("a\nb\nc")))).
+		OrPanic("Unexpected error")
+	assert.Equal(t, []string{"a", "b", "c"}, lines)
+}
+
+// mockRows is a mock RowScanner holding one int per row
+type mockRows struct {
+	rows []int
+	i    int
+}
+
+func (r *mockRows) Next() bool {
+	r.i++
+	return r.i <= len(r.rows)
+}
+
+func (r *mockRows) Scan(dest ...any) error {
+	*(dest[0].(*int)) = r.rows[r.i-1]
+	return nil
+}
+
+func (r *mockRows) Err() error {
+	return nil
+}
+
+func TestRows(t *testing.T) {
+	scan := func(r result.RowScanner) (int, error) {
+		var i int
+		err := r.Scan(&i)
+		if i < 0 {
+			return 0, errors.New("Negative row")
+		}
+		return i, err
+	}
+	vs := result.ScanAll(result.Rows(&mockRows{rows: []int{5, 6}}, scan)).
+		OrPanic("Unexpected error")
+	assert.Equal(t, []int{5, 6}, vs)
+	assert.EqualError(t, result.ScanAll(result.Rows(&mockRows{rows: []int{5, -1, 6}}, scan)), "Negative row")
+}
+
+func TestScanLines(t *testing.T) {
+	s := result.ScanLines(strings.NewReader("1\nx\n3"), strconv.Atoi)
+	got := []string{}
+	for s.Next() {
+		v := s.Value()
+		if v.Ok() {
+			got = append(got, strconv.Itoa(v.OrPanic("Unexpected error")))
+			continue
+		}
+		got = append(got, v.Error())
+	}
+	assert.Equal(t, []string{"1", `strconv.Atoi: parsing "x": invalid syntax`, "3"}, got)
+	assert.Nil(t, s.Err())
+	assert.EqualError(t, s.Value(), "Scanner had no more values: EOF")
+}
+
+// failingReader returns an error on its first read
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("Expected read error")
+}
+
+func TestScannerOfReadError(t *testing.T) {
+	s := result.ScannerOf(failingReader{}, bufio.ScanWords, func(b []byte) (string, error) {
+		return string(b), nil
+	})
+	assert.False(t, s.Next())
+	assert.EqualError(t, s.Err(), "Expected read error")
+	assert.EqualError(t, s.Value(), "Expected read error")
+}
@@ -0,0 +1,232 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Singleton returns the only ok Val in vs. If vs doesn't contain exactly one ok Val, Singleton returns an error Val.
+// Error Vals in vs are ignored if there's exactly one ok Val; otherwise their errors are included in the returned error.
+// Useful when a query is expected to return exactly one row, e.g:
+//     user := result.Singleton(usersWithEmail(email)).
+//         OrError("Couldn't find a unique user")
+func Singleton[T any](vs []Val[T]) Val[T] {
+	oks, errs := splitVals(vs)
+	if len(oks) != 1 {
+		return ValError[T](countError(len(oks), errs))
+	}
+	return oks[0]
+}
+
+// SingletonStrict is like Singleton, but returns an error Val if any Val in vs is an error, even if there's exactly one
+// ok Val
+func SingletonStrict[T any](vs []Val[T]) Val[T] {
+	oks, errs := splitVals(vs)
+	if len(errs) > 0 {
+		return ValErrorf[T]("expected no errors, got %v: %v", len(errs), joinMessages(errs))
+	}
+	if len(oks) != 1 {
+		return ValError[T](countError(len(oks), errs))
+	}
+	return oks[0]
+}
+
+// splitVals separates vs into its ok Vals and the errors of its error Vals, preserving order
+func splitVals[T any](vs []Val[T]) ([]Val[T], []error) {
+	oks := []Val[T]{}
+	errs := []error{}
+	for _, v := range vs {
+		if v.err != nil {
+			errs = append(errs, v.err)
+			continue
+		}
+		oks = append(oks, v)
+	}
+	return oks, errs
+}
+
+// countError returns the error for a slice that had n ok results instead of exactly 1, including any errors found
+func countError(n int, errs []error) error {
+	if len(errs) == 0 {
+		return fmt.Errorf("expected exactly 1 result, got %v", n)
+	}
+	return fmt.Errorf("expected exactly 1 result, got %v (errors: %v)", n, joinMessages(errs))
+}
+
+// joinMessages returns the messages of errs separated by "; "
+func joinMessages(errs []error) string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Difference returns the Vals in a whose key isn't the key of any ok Val in b, in order. Error Vals in a are passed
+// through; error Vals in b are ignored. Usage:
+//     toCreate := result.Difference(desired, actual, func(u User) int {
+//         return u.ID
+//     })
+func Difference[T any, K comparable](a, b []Val[T], key func(T) K) []Val[T] {
+	keys := okKeys(b, key)
+	vs := []Val[T]{}
+	for _, v := range a {
+		if v.err == nil {
+			if _, ok := keys[key(v.v)]; ok {
+				continue
+			}
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// Intersection returns the Vals in a whose key is also the key of an ok Val in b, in order. Error Vals in a are passed
+// through; error Vals in b are ignored
+func Intersection[T any, K comparable](a, b []Val[T], key func(T) K) []Val[T] {
+	keys := okKeys(b, key)
+	vs := []Val[T]{}
+	for _, v := range a {
+		if v.err == nil {
+			if _, ok := keys[key(v.v)]; !ok {
+				continue
+			}
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// Union returns the Vals in a followed by the Vals in b, keeping only the first ok Val for each key. Error Vals from
+// both a and b are passed through
+func Union[T any, K comparable](a, b []Val[T], key func(T) K) []Val[T] {
+	keys := map[K]struct{}{}
+	vs := []Val[T]{}
+	for _, v := range append(a[:len(a):len(a)], b...) {
+		if v.err == nil {
+			k := key(v.v)
+			if _, ok := keys[k]; ok {
+				continue
+			}
+			keys[k] = struct{}{}
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// okKeys returns the set of keys of the ok Vals in vs
+func okKeys[T any, K comparable](vs []Val[T], key func(T) K) map[K]struct{} {
+	keys := map[K]struct{}{}
+	for _, v := range vs {
+		if v.err == nil {
+			keys[key(v.v)] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// FoldLeft combines the values of vs from left to right, starting with initial and calling f with the accumulated value
+// and each element. It stops and returns an error Val at the first error element, or the first error returned by f.
+// Usage:
+//     total := result.FoldLeft(prices, 0, func(sum, p int) (int, error) {
+//         return sum + p, nil
+//     }).
+//         OrError("Couldn't total prices")
+func FoldLeft[T, A any](vs []Val[T], initial A, f func(A, T) (A, error)) Val[A] {
+	acc := initial
+	for _, v := range vs {
+		if v.err != nil {
+			return ValError[A](v.err)
+		}
+		var err error
+		if acc, err = f(acc, v.v); err != nil {
+			return ValError[A](err)
+		}
+	}
+	return NewVal(acc)
+}
+
+// FoldRight is like FoldLeft, but combines the values of vs from right to left
+func FoldRight[T, A any](vs []Val[T], initial A, f func(A, T) (A, error)) Val[A] {
+	acc := initial
+	for i := len(vs) - 1; i >= 0; i-- {
+		if vs[i].err != nil {
+			return ValError[A](vs[i].err)
+		}
+		var err error
+		if acc, err = f(acc, vs[i].v); err != nil {
+			return ValError[A](err)
+		}
+	}
+	return NewVal(acc)
+}
+
+// Collect returns the values of vs in order, if they're all ok. Otherwise, it returns the first error. Usage:
+//     users := result.Collect(lookups).
+//         OrError("Couldn't look up all users")
+func Collect[T any](vs []Val[T]) Val[[]T] {
+	ts := make([]T, 0, len(vs))
+	for _, v := range vs {
+		if v.err != nil {
+			return ValError[[]T](v.err)
+		}
+		ts = append(ts, v.v)
+	}
+	return NewVal(ts)
+}
+
+// CollectAll is like Collect, but if any of vs are errors, it returns all of their errors combined with errors.Join
+func CollectAll[T any](vs []Val[T]) Val[[]T] {
+	oks, errs := splitVals(vs)
+	if len(errs) > 0 {
+		return ValError[[]T](errors.Join(errs...))
+	}
+	ts := make([]T, len(oks))
+	for i, v := range oks {
+		ts[i] = v.v
+	}
+	return NewVal(ts)
+}
+
+// Traverse calls f for each of items in order, and returns all of the values if they're all ok. It stops at the first
+// error and returns it. Usage:
+//     ages := result.Traverse(inputs, parseAge).
+//         OrError("Couldn't parse ages")
+func Traverse[T, U any](items []T, f func(T) Val[U]) Val[[]U] {
+	us := make([]U, 0, len(items))
+	for _, item := range items {
+		v := f(item)
+		if v.err != nil {
+			return ValError[[]U](v.err)
+		}
+		us = append(us, v.v)
+	}
+	return NewVal(us)
+}
+
+// TraverseAll is like Traverse, but calls f for every item even after an error. If there are any errors, it returns
+// all of them combined with errors.Join
+func TraverseAll[T, U any](items []T, f func(T) Val[U]) Val[[]U] {
+	vs := make([]Val[U], len(items))
+	for i, item := range items {
+		vs[i] = f(item)
+	}
+	return CollectAll(vs)
+}
+
+// Partition separates vs into the values of its ok Vals and the errors of its error Vals, both in order. Usage:
+//     uploaded, failures := result.Partition(uploads)
+func Partition[T any](vs []Val[T]) ([]T, []error) {
+	ts := []T{}
+	errs := []error{}
+	for _, v := range vs {
+		if v.err != nil {
+			errs = append(errs, v.err)
+			continue
+		}
+		ts = append(ts, v.v)
+	}
+	return ts, errs
+}
@@ -0,0 +1,243 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingletonEmpty(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Singleton([]result.Val[int]{}),
+		"expected exactly 1 result, got 0",
+	)
+}
+
+func TestSingletonOneOk(t *testing.T) {
+	v := result.Singleton([]result.Val[int]{result.NewVal(5)}).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 5, v)
+}
+
+func TestSingletonTwoOk(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Singleton([]result.Val[int]{result.NewVal(1), result.NewVal(2)}),
+		"expected exactly 1 result, got 2",
+	)
+}
+
+func TestSingletonMixed(t *testing.T) {
+	vs := []result.Val[int]{
+		result.ValErrorf[int]("Expected error"),
+		result.NewVal(3),
+	}
+	v := result.Singleton(vs).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 3, v)
+
+	vs = append(vs, result.NewVal(4))
+	assert.EqualError(
+		t,
+		result.Singleton(vs),
+		"expected exactly 1 result, got 2 (errors: Expected error)",
+	)
+}
+
+func TestSingletonAllErrors(t *testing.T) {
+	assert.EqualError(
+		t,
+		result.Singleton([]result.Val[int]{
+			result.ValErrorf[int]("Error a"),
+			result.ValErrorf[int]("Error b"),
+		}),
+		"expected exactly 1 result, got 0 (errors: Error a; Error b)",
+	)
+}
+
+func TestSingletonStrict(t *testing.T) {
+	v := result.SingletonStrict([]result.Val[int]{result.NewVal(5)}).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 5, v)
+
+	assert.EqualError(
+		t,
+		result.SingletonStrict([]result.Val[int]{
+			result.ValErrorf[int]("Expected error"),
+			result.NewVal(3),
+		}),
+		"expected no errors, got 1: Expected error",
+	)
+	assert.EqualError(
+		t,
+		result.SingletonStrict([]result.Val[int]{}),
+		"expected exactly 1 result, got 0",
+	)
+}
+
+func setIdentity(i int) int {
+	return i
+}
+
+// setVals returns a Val for each of is. Negative numbers become error Vals
+func setVals(is ...int) []result.Val[int] {
+	vs := []result.Val[int]{}
+	for _, i := range is {
+		if i < 0 {
+			vs = append(vs, result.ValErrorf[int]("Error %v", i))
+			continue
+		}
+		vs = append(vs, result.NewVal(i))
+	}
+	return vs
+}
+
+// setStrings describes vs as strings, for easy comparison
+func setStrings(vs []result.Val[int]) []string {
+	s := []string{}
+	for _, v := range vs {
+		if v.Ok() {
+			s = append(s, fmt.Sprint(v.OrPanic("Unexpected error")))
+			continue
+		}
+		s = append(s, v.Error())
+	}
+	return s
+}
+
+func TestDifference(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"1", "Error -1", "3"},
+		setStrings(result.Difference(setVals(1, 2, -1, 3), setVals(2, -2, 4), setIdentity)),
+	)
+	assert.Equal(
+		t,
+		[]string{"1", "2"},
+		setStrings(result.Difference(setVals(1, 2), setVals(3, 4), setIdentity)),
+	)
+}
+
+func TestIntersection(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"2", "Error -1"},
+		setStrings(result.Intersection(setVals(1, 2, -1, 3), setVals(2, -2, 4), setIdentity)),
+	)
+	assert.Equal(
+		t,
+		[]string{},
+		setStrings(result.Intersection(setVals(1, 2), setVals(3, 4), setIdentity)),
+	)
+}
+
+func TestUnion(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"1", "2", "Error -1", "3", "Error -2", "4"},
+		setStrings(result.Union(setVals(1, 2, -1, 3), setVals(2, -2, 4), setIdentity)),
+	)
+	assert.Equal(
+		t,
+		[]string{"1", "2", "3", "4"},
+		setStrings(result.Union(setVals(1, 2), setVals(3, 4), setIdentity)),
+	)
+}
+
+func foldConcat(acc string, i int) (string, error) {
+	if i == 0 {
+		return "", errors.New("Zero not allowed")
+	}
+	return acc + fmt.Sprint(i), nil
+}
+
+func TestFoldLeft(t *testing.T) {
+	s := result.FoldLeft(setVals(1, 2, 3), ">", foldConcat).
+		OrPanic("Unexpected error")
+	assert.Equal(t, ">123", s)
+}
+
+func TestFoldLeftElementError(t *testing.T) {
+	calls := 0
+	v := result.FoldLeft(setVals(1, -1, 3), "", func(acc string, i int) (string, error) {
+		calls++
+		return foldConcat(acc, i)
+	})
+	assert.EqualError(t, v, "Error -1")
+	assert.Equal(t, 1, calls)
+}
+
+func TestFoldLeftFuncError(t *testing.T) {
+	assert.EqualError(t, result.FoldLeft(setVals(1, 0, 3), "", foldConcat), "Zero not allowed")
+}
+
+func TestFoldLeftEmpty(t *testing.T) {
+	s := result.FoldLeft(setVals(), "initial", foldConcat).
+		OrPanic("Unexpected error")
+	assert.Equal(t, "initial", s)
+}
+
+func TestFoldRight(t *testing.T) {
+	s := result.FoldRight(setVals(1, 2, 3), ">", foldConcat).
+		OrPanic("Unexpected error")
+	assert.Equal(t, ">321", s)
+	assert.EqualError(t, result.FoldRight(setVals(-1, 2, 0), "", foldConcat), "Zero not allowed")
+}
+
+func TestCollect(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, result.Collect(setVals(1, 2, 3)).OrPanic("Unexpected error"))
+	assert.Equal(t, []int{}, result.Collect(setVals()).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Collect(setVals(1, -1, -2)), "Error -1")
+}
+
+func TestCollectAll(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, result.CollectAll(setVals(1, 2, 3)).OrPanic("Unexpected error"))
+	assert.Equal(t, []int{}, result.CollectAll(setVals()).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.CollectAll(setVals(1, -1, 2, -2)), "Error -1\nError -2")
+}
+
+func TestTraverse(t *testing.T) {
+	assert.Equal(t, []int{}, result.Traverse([]string{}, parseInt).OrPanic("Unexpected error"))
+	assert.Equal(t, []int{1, 2}, result.Traverse([]string{"1", "2"}, parseInt).OrPanic("Unexpected error"))
+
+	calls := 0
+	v := result.Traverse([]string{"1", "x", "y"}, func(s string) result.Val[int] {
+		calls++
+		return parseInt(s)
+	})
+	assert.EqualError(t, v, `strconv.Atoi: parsing "x": invalid syntax`)
+	assert.Equal(t, 2, calls)
+}
+
+func TestTraverseAll(t *testing.T) {
+	assert.Equal(t, []int{}, result.TraverseAll([]string{}, parseInt).OrPanic("Unexpected error"))
+	assert.Equal(t, []int{1, 2}, result.TraverseAll([]string{"1", "2"}, parseInt).OrPanic("Unexpected error"))
+	assert.EqualError(
+		t,
+		result.TraverseAll([]string{"1", "x", "y"}, parseInt),
+		"strconv.Atoi: parsing \"x\": invalid syntax\nstrconv.Atoi: parsing \"y\": invalid syntax",
+	)
+}
+
+func TestPartition(t *testing.T) {
+	ts, errs := result.Partition(setVals(1, 2))
+	assert.Equal(t, []int{1, 2}, ts)
+	assert.Empty(t, errs)
+
+	ts, errs = result.Partition(setVals(-1, -2))
+	assert.Empty(t, ts)
+	assert.Len(t, errs, 2)
+
+	ts, errs = result.Partition(setVals(1, -1, 2, -2))
+	assert.Equal(t, []int{1, 2}, ts)
+	assert.EqualError(t, errs[0], "Error -1")
+	assert.EqualError(t, errs[1], "Error -2")
+
+	ts, errs = result.Partition(setVals())
+	assert.Empty(t, ts)
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,42 @@
+package result
+
+import (
+	"sort"
+)
+
+// ValCmp wraps a Val to order it relative to other Vals: ok results come before error results, ok results are ordered
+// by their values, and error results are ordered by their error messages
+type ValCmp[T any] struct {
+	Val[T]
+}
+
+// Less returns whether c comes before other. less is used to compare two ok values
+func (c ValCmp[T]) Less(other ValCmp[T], less func(T, T) bool) bool {
+	switch {
+	case c.err == nil && other.err == nil:
+		return less(c.v, other.v)
+	case c.err == nil:
+		return true
+	case other.err == nil:
+		return false
+	}
+	return c.err.Error() < other.err.Error()
+}
+
+// SortVals sorts vs in place, with ok results first in the order given by less, followed by error results ordered by
+// their error messages. Usage:
+//     result.SortVals(users, func(a, b User) bool {
+//         return a.Name < b.Name
+//     })
+func SortVals[T any](vs []Val[T], less func(T, T) bool) {
+	sort.Slice(vs, func(i, j int) bool {
+		return ValCmp[T]{vs[i]}.Less(ValCmp[T]{vs[j]}, less)
+	})
+}
+
+// SortValsStable is like SortVals, but keeps equal elements in their original order
+func SortValsStable[T any](vs []Val[T], less func(T, T) bool) {
+	sort.SliceStable(vs, func(i, j int) bool {
+		return ValCmp[T]{vs[i]}.Less(ValCmp[T]{vs[j]}, less)
+	})
+}
@@ -0,0 +1,53 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func sortLess(a, b int) bool {
+	return a < b
+}
+
+func TestValCmpLess(t *testing.T) {
+	ok := result.ValCmp[int]{Val: result.NewVal(2)}
+	err := result.ValCmp[int]{Val: result.ValErrorf[int]("Error")}
+	assert.True(t, ok.Less(err, sortLess))
+	assert.False(t, err.Less(ok, sortLess))
+	assert.True(t, result.ValCmp[int]{Val: result.NewVal(1)}.Less(ok, sortLess))
+	assert.False(t, ok.Less(ok, sortLess))
+}
+
+func TestSortVals(t *testing.T) {
+	vs := []result.Val[int]{
+		result.ValErrorf[int]("Error b"),
+		result.NewVal(3),
+		result.ValErrorf[int]("Error a"),
+		result.NewVal(1),
+		result.NewVal(2),
+	}
+	result.SortVals(vs, sortLess)
+	assert.Equal(t, []string{"1", "2", "3", "Error a", "Error b"}, setStrings(vs))
+}
+
+func TestSortValsStable(t *testing.T) {
+	type pair struct {
+		key, order int
+	}
+	vs := []result.Val[pair]{
+		result.NewVal(pair{2, 0}),
+		result.NewVal(pair{1, 1}),
+		result.NewVal(pair{2, 2}),
+		result.NewVal(pair{1, 3}),
+	}
+	result.SortValsStable(vs, func(a, b pair) bool {
+		return a.key < b.key
+	})
+	orders := []int{}
+	for _, v := range vs {
+		orders = append(orders, v.OrPanic("Unexpected error").order)
+	}
+	assert.Equal(t, []int{1, 3, 0, 2}, orders)
+}
@@ -0,0 +1,141 @@
+package sql_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// fakeDriver is a database/sql driver for tests. Every query returns one int column, with the rows given as the query
+// text, e.g. "1,2,3". An empty query returns no rows
+type fakeDriver struct {
+	mu         sync.Mutex
+	commits    int
+	rollbacks  int
+	failCommit bool // Makes the next commit fail
+}
+
+var fakeDriverCount atomic.Int64
+
+// openFake registers a new fakeDriver and returns a DB that uses it
+func openFake() (*sql.DB, *fakeDriver) {
+	d := &fakeDriver{}
+	name := "fake" + strconv.FormatInt(fakeDriverCount.Add(1), 10)
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		panic(err)
+	}
+	return db, d
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return &fakeTx{c.d}, nil
+}
+
+type fakeTx struct {
+	d *fakeDriver
+}
+
+func (t *fakeTx) Commit() error {
+	t.d.mu.Lock()
+	defer t.d.mu.Unlock()
+	if t.d.failCommit {
+		t.d.failCommit = false
+		return errors.New("Expected commit error")
+	}
+	t.d.commits++
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.d.mu.Lock()
+	defer t.d.mu.Unlock()
+	t.d.rollbacks++
+	return nil
+}
+
+type fakeStmt struct {
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return 0
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	r := &fakeRows{}
+	if s.query == "" {
+		return r, nil
+	}
+	for _, f := range splitComma(s.query) {
+		i, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+		r.vs = append(r.vs, int64(i))
+	}
+	return r, nil
+}
+
+type fakeRows struct {
+	vs []int64
+	i  int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"v"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.vs) {
+		return io.EOF
+	}
+	dest[0] = r.vs[r.i]
+	r.i++
+	return nil
+}
+
+func splitComma(s string) []string {
+	fs := []string{}
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ',' {
+			fs = append(fs, s[start:i])
+			start = i + 1
+		}
+	}
+	return fs
+}
@@ -0,0 +1,63 @@
+// Package sql reads database/sql rows into results. It's separate from the result package so that callers who don't
+// need it don't import database/sql
+package sql
+
+import (
+	"database/sql"
+
+	"github.com/bmheenan/result"
+)
+
+// FromSQLRow reads row with scan, and returns its value. If there was no row, the error Val wraps sql.ErrNoRows, so it
+// can be checked with errors.Is. Usage:
+//     u := sql.FromSQLRow(db.QueryRow(q, id), func(r *sql.Row) (User, error) {
+//         var u User
+//         err := r.Scan(&u.ID, &u.Name)
+//         return u, err
+//     }).
+//         OrError("Couldn't find user")
+func FromSQLRow[T any](row *sql.Row, scan func(*sql.Row) (T, error)) result.Val[T] {
+	return result.TryVal(scan(row))
+}
+
+// FromSQLRows reads each of rows with scan, and returns their values. It stops at the first error from scan or rows.
+// rows is always closed
+func FromSQLRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) result.Val[[]T] {
+	defer rows.Close()
+	vs := []T{}
+	for rows.Next() {
+		v, err := scan(rows)
+		if err != nil {
+			return result.ValError[[]T](err)
+		}
+		vs = append(vs, v)
+	}
+	if err := rows.Err(); err != nil {
+		return result.ValError[[]T](err)
+	}
+	return result.NewVal(vs)
+}
+
+// TxResult calls f in a new transaction on db. If f returns an ok Val, the transaction is committed; otherwise it's
+// rolled back, and f's error is returned even if rolling back also fails. If f panics, the transaction is rolled back
+// before the panic continues. Usage:
+//     id := sql.TxResult(db, func(tx *sql.Tx) result.Val[int64] {
+//         return insertOrder(tx, o)
+//     }).
+//         OrError("Couldn't save order")
+func TxResult[T any](db *sql.DB, f func(*sql.Tx) result.Val[T]) result.Val[T] {
+	tx, err := db.Begin()
+	if err != nil {
+		return result.ValErrorf[T]("Couldn't begin transaction: %w", err)
+	}
+	// Rollback does nothing once the transaction is committed, so this only rolls back if f fails or panics
+	defer tx.Rollback()
+	v := f(tx)
+	if !v.Ok() {
+		return v
+	}
+	if err := tx.Commit(); err != nil {
+		return result.ValErrorf[T]("Couldn't commit transaction: %w", err)
+	}
+	return v
+}
@@ -0,0 +1,94 @@
+package sql_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+	resultsql "github.com/bmheenan/result/sql"
+	"github.com/stretchr/testify/assert"
+)
+
+func scanRow(r *sql.Row) (int, error) {
+	var i int
+	err := r.Scan(&i)
+	return i, err
+}
+
+func scanRows(r *sql.Rows) (int, error) {
+	var i int
+	err := r.Scan(&i)
+	if i < 0 {
+		return 0, errors.New("Negative row")
+	}
+	return i, err
+}
+
+func TestFromSQLRow(t *testing.T) {
+	db, _ := openFake()
+	defer db.Close()
+	assert.Equal(t, 1, resultsql.FromSQLRow(db.QueryRow("1"), scanRow).OrPanic("Unexpected error"))
+	assert.True(t, errors.Is(resultsql.FromSQLRow(db.QueryRow(""), scanRow), sql.ErrNoRows))
+}
+
+func TestFromSQLRows(t *testing.T) {
+	db, _ := openFake()
+	defer db.Close()
+	rows, err := db.Query("1,2,3")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, resultsql.FromSQLRows(rows, scanRows).OrPanic("Unexpected error"))
+	assert.False(t, rows.Next())
+
+	rows, err = db.Query("1,-2,3")
+	assert.Nil(t, err)
+	assert.EqualError(t, resultsql.FromSQLRows(rows, scanRows), "Negative row")
+	assert.False(t, rows.Next())
+
+	rows, err = db.Query("")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{}, resultsql.FromSQLRows(rows, scanRows).OrPanic("Unexpected error"))
+}
+
+func TestTxResultCommit(t *testing.T) {
+	db, d := openFake()
+	defer db.Close()
+	v := resultsql.TxResult(db, func(tx *sql.Tx) result.Val[int] {
+		return resultsql.FromSQLRow(tx.QueryRow("1"), scanRow)
+	})
+	assert.Equal(t, 1, v.OrPanic("Unexpected error"))
+	assert.Equal(t, 1, d.commits)
+	assert.Equal(t, 0, d.rollbacks)
+}
+
+func TestTxResultRollback(t *testing.T) {
+	db, d := openFake()
+	defer db.Close()
+	v := resultsql.TxResult(db, func(tx *sql.Tx) result.Val[int] {
+		return result.ValErrorf[int]("Expected error")
+	})
+	assert.EqualError(t, v, "Expected error")
+	assert.Equal(t, 0, d.commits)
+	assert.Equal(t, 1, d.rollbacks)
+}
+
+func TestTxResultCommitError(t *testing.T) {
+	db, d := openFake()
+	defer db.Close()
+	d.failCommit = true
+	v := resultsql.TxResult(db, func(tx *sql.Tx) result.Val[int] {
+		return result.NewVal(1)
+	})
+	assert.EqualError(t, v, "Couldn't commit transaction: Expected commit error")
+}
+
+func TestTxResultPanic(t *testing.T) {
+	db, d := openFake()
+	defer db.Close()
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		resultsql.TxResult(db, func(tx *sql.Tx) result.Val[int] {
+			panic("Expected panic")
+		})
+	})
+	assert.Equal(t, 1, d.rollbacks)
+}
@@ -17,6 +17,13 @@ type Status struct {
 	base
 }
 
+// Status satisfies the error interface, so it can be passed directly to anything that accepts an error, e.g:
+//     log.Fatal(doWork())
+// This is a deliberate design decision with one quirk: an ok Status is still a non-nil error, whose Error() returns "".
+// Only pass a Status as an error once you know it isn't ok, or where an empty error is harmless. Unwrap exposes the
+// underlying error so errors.Is and errors.As can see through a Status
+var _ error = Status{}
+
 // Ok returns a new ok Status
 func Ok() Status {
 	return Status{}
@@ -51,6 +58,25 @@ func Try(err error) Status {
 	return Error(err)
 }
 
+// StatusFromBool returns an ok Status if cond is true, or an error Status with errMsg if it's false. Usage:
+//     result.StatusFromBool(cache.Delete(key), "Key wasn't cached").
+//         OrError("Couldn't evict key")
+func StatusFromBool(cond bool, errMsg string) Status {
+	if cond {
+		return Ok()
+	}
+	return Errorf("%s", errMsg)
+}
+
+// StatusFromBoolf is like StatusFromBool, with an error made from the given format string and arguments, like
+// fmt.Errorf. They're only formatted if cond is false
+func StatusFromBoolf(cond bool, format string, args ...any) Status {
+	if cond {
+		return Ok()
+	}
+	return Errorf(format, args...)
+}
+
 // OrError does nothing if the Status is ok. Otherwise, it stops execution of the calling function and returns an error.
 // Use e to provide an explanation about what went wrong; it will be included in the returned error.
 //
@@ -68,7 +94,20 @@ func (s Status) OrError(e string) {
 		return
 	}
 	panic(panicToError{
-		err: fmt.Errorf("%v: %v", e, s.err),
+		err: fmt.Errorf("%v: %w", e, s.err),
+	})
+}
+
+// OrErrorf is like OrError, but builds the explanation from a format string and arguments, like fmt.Sprintf. The
+// explanation is only formatted if the Status is an error. Usage:
+//     saveUser(u).
+//         OrErrorf("Couldn't save user %v", u.ID)
+func (s Status) OrErrorf(format string, args ...any) {
+	if s.err == nil {
+		return
+	}
+	panic(panicToError{
+		err: fmt.Errorf("%v: %w", fmt.Sprintf(format, args...), s.err),
 	})
 }
 
@@ -108,7 +147,15 @@ func (s Status) OrPanic(p string) {
 	if s.err == nil {
 		return
 	}
-	panic(fmt.Errorf("%v: %v", p, s.err))
+	panic(fmt.Errorf("%v: %w", p, s.err))
+}
+
+// MustStatus does nothing if s is ok. Otherwise, it panics with s's error, unchanged. Like OrPanic, this panic will not
+// be caught by Handle, HandleError, or HandleReturn
+func MustStatus(s Status) {
+	if s.err != nil {
+		panic(s.err)
+	}
 }
 
 // OrDo does nothing if the Status is ok. Otherwise, it executes the provided function f. Usage:
@@ -2,6 +2,7 @@ package result_test
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/bmheenan/result"
@@ -177,3 +178,41 @@ func TestTryError(t *testing.T) {
 func TestErrorIsEmpty(t *testing.T) {
 	assert.Equal(t, "", result.Ok().Error())
 }
+
+func TestStatusIsError(t *testing.T) {
+	var err error = result.Error(io.EOF)
+	assert.EqualError(t, err, "EOF")
+	assert.True(t, errors.Is(err, io.EOF))
+	assert.Nil(t, result.Ok().Unwrap())
+}
+
+func statusOrErrorf() (res result.Status) {
+	defer result.Handle(&res)
+	result.Ok().
+		OrErrorf("Unexpected error %v", 1)
+	result.Error(io.EOF).
+		OrErrorf("Context %v", 2)
+	return result.Ok()
+}
+
+func TestStatusOrErrorf(t *testing.T) {
+	s := statusOrErrorf()
+	assert.EqualError(t, s, "Context 2: EOF")
+	assert.True(t, errors.Is(s, io.EOF))
+}
+
+func TestMustStatus(t *testing.T) {
+	assert.NotPanics(t, func() {
+		result.MustStatus(result.Ok())
+	})
+	assert.PanicsWithError(t, "EOF", func() {
+		result.MustStatus(result.Error(io.EOF))
+	})
+}
+
+func TestStatusFromBool(t *testing.T) {
+	assert.True(t, result.StatusFromBool(true, "Unexpected error").Ok())
+	assert.EqualError(t, result.StatusFromBool(false, "100% wrong"), "100% wrong")
+	assert.True(t, result.StatusFromBoolf(true, "Unexpected error %v", 1).Ok())
+	assert.EqualError(t, result.StatusFromBoolf(false, "Expected error %v", 1), "Expected error 1")
+}
@@ -0,0 +1,198 @@
+package result
+
+import (
+	"errors"
+)
+
+// Map transforms the value of v with f, if v is ok. If v is an error, f isn't called and the error is passed through.
+// f returns a plain U rather than a Val[U], so it can't fail; this keeps Map the usual functor map, and lets existing
+// functions like strconv.Itoa be passed in directly. Use Chain when f can fail. Usage:
+//     name := result.Map(fetchUser(id), func(u User) string {
+//         return u.Name
+//     })
+func Map[T, U any](v Val[T], f func(T) U) Val[U] {
+	if v.err != nil {
+		return ValError[U](v.err)
+	}
+	return NewVal(f(v.v))
+}
+
+// MapStatus checks the value of v with f, if v is ok, and returns f's Status. If v is an error, f isn't called and the
+// error is passed through as a Status. Usage:
+//     result.MapStatus(parseAge(raw), validateAge).
+//         OrError("Invalid age")
+func MapStatus[T any](v Val[T], f func(T) Status) Status {
+	if v.err != nil {
+		return Error(v.err)
+	}
+	return f(v.v)
+}
+
+// Chain calls f with the value of v, if v is ok, and returns f's result. If v is an error, f isn't called and the error
+// is passed through. This lets result-returning functions be chained without a handler. Usage:
+//     cfg := result.Chain(readFile(path), parseConfig).
+//         OrError("Couldn't load config")
+func Chain[T, U any](v Val[T], f func(T) Val[U]) Val[U] {
+	if v.err != nil {
+		return ValError[U](v.err)
+	}
+	return f(v.v)
+}
+
+// ChainStatus calls f with the value of v, if v is ok, and returns f's Status. It's the same as MapStatus, for use at
+// the end of a chain of calls to Chain
+func ChainStatus[T any](v Val[T], f func(T) Status) Status {
+	return MapStatus(v, f)
+}
+
+// Apply calls the function in vf with the value of vx, if both are ok. If vf is an error, its error is passed through.
+// Otherwise, if vx is an error, its error is passed through. Usage:
+//     price := result.Apply(lookupPricer(region), parseAmount(input)).
+//         OrError("Couldn't price amount")
+func Apply[T, U any](vf Val[func(T) U], vx Val[T]) Val[U] {
+	if vf.err != nil {
+		return ValError[U](vf.err)
+	}
+	if vx.err != nil {
+		return ValError[U](vx.err)
+	}
+	return NewVal(vf.v(vx.v))
+}
+
+// Flatten unwraps a nested result. If v is ok, its value, which may be ok or an error, is returned. If v is an error,
+// the error is passed through. Chain(v, f) is the same as Flatten(Map(v, f))
+func Flatten[T any](v Val[Val[T]]) Val[T] {
+	if v.err != nil {
+		return ValError[T](v.err)
+	}
+	return v.v
+}
+
+// Filter returns v unchanged if it's ok and its value matches predicate. If the value doesn't match, Filter returns an
+// error Val with errMsg. If v is already an error, predicate isn't called and the error is passed through. Usage:
+//     age := result.Filter(readAge(input), func(a int) bool {
+//         return a >= 0
+//     }, "age must be non-negative")
+func Filter[T any](v Val[T], predicate func(T) bool, errMsg string) Val[T] {
+	if v.err != nil {
+		return v
+	}
+	if !predicate(v.v) {
+		return ValError[T](errors.New(errMsg))
+	}
+	return v
+}
+
+// MapError replaces the error of v with the one returned by f, if v is an error. If v is ok, f isn't called and v is
+// returned unchanged. If f returns nil, the result is an ok Val with the zero value. Usage:
+//     user := result.MapError(fetchUser(id), func(e error) error {
+//         return fmt.Errorf("user service unavailable: %w", e)
+//     })
+func MapError[T any](v Val[T], f func(error) error) Val[T] {
+	if v.err == nil {
+		return v
+	}
+	return ValError[T](f(v.err))
+}
+
+// MapErrorStatus replaces the error of s with the one returned by f, if s is an error. If s is ok, f isn't called and s
+// is returned unchanged. It's MapError for Status; MapStatus is taken by the Val-to-Status form of Map
+func MapErrorStatus(s Status, f func(error) error) Status {
+	if s.err == nil {
+		return s
+	}
+	return Try(f(s.err))
+}
+
+// Tap calls f with the value of v if v is ok, then returns v unchanged. It's for side effects like logging in the
+// middle of a chain. Usage:
+//     user := result.Tap(parseUser(raw), func(u User) {
+//         log.Printf("Parsed user %v", u.ID)
+//     })
+func Tap[T any](v Val[T], f func(T)) Val[T] {
+	if v.err == nil {
+		f(v.v)
+	}
+	return v
+}
+
+// TapErr calls f with the error of v if v is an error, then returns v unchanged
+func TapErr[T any](v Val[T], f func(error)) Val[T] {
+	if v.err != nil {
+		f(v.err)
+	}
+	return v
+}
+
+// TapStatus calls f if s is ok, then returns s unchanged
+func TapStatus(s Status, f func()) Status {
+	if s.err == nil {
+		f()
+	}
+	return s
+}
+
+// TapStatusErr calls f with the error of s if s is an error, then returns s unchanged
+func TapStatusErr(s Status, f func(error)) Status {
+	if s.err != nil {
+		f(s.err)
+	}
+	return s
+}
+
+// Match calls ok with the value of v if v is ok, or err with its error otherwise, and returns what the called function
+// returns. Usage:
+//     msg := result.Match(lookup(id), func(u User) string {
+//         return u.Name
+//     }, func(e error) string {
+//         return e.Error()
+//     })
+func Match[T, U any](v Val[T], ok func(T) U, err func(error) U) U {
+	if v.err != nil {
+		return err(v.err)
+	}
+	return ok(v.v)
+}
+
+// MatchStatus calls ok if s is ok, or err with its error otherwise, and returns what the called function returns
+func MatchStatus[U any](s Status, ok func() U, err func(error) U) U {
+	if s.err != nil {
+		return err(s.err)
+	}
+	return ok()
+}
+
+// OrElse returns v unchanged if it's ok. Otherwise, it calls f and returns its result. Unlike OrUse, the fallback is
+// only computed when it's needed, and may itself fail. Usage:
+//     user := result.OrElse(primaryCache(id), func() result.Val[User] {
+//         return secondaryCache(id)
+//     })
+func OrElse[T any](v Val[T], f func() Val[T]) Val[T] {
+	if v.err == nil {
+		return v
+	}
+	return f()
+}
+
+// OrElseStatus returns s unchanged if it's ok. Otherwise, it calls f and returns its Status
+func OrElseStatus(s Status, f func() Status) Status {
+	if s.err == nil {
+		return s
+	}
+	return f()
+}
+
+// BindError returns v unchanged if it's ok. Otherwise, it calls f with v's error and returns its result. It's the
+// counterpart of Chain for the error path, and is useful to recover from specific errors. Usage:
+//     prices := result.BindError(fetchPrices(), func(err error) result.Val[Prices] {
+//         if errors.Is(err, os.ErrDeadlineExceeded) {
+//             return cachedPrices()
+//         }
+//         return result.ValError[Prices](err)
+//     })
+func BindError[T any](v Val[T], f func(error) Val[T]) Val[T] {
+	if v.err == nil {
+		return v
+	}
+	return f(v.err)
+}
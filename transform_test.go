@@ -0,0 +1,220 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapOk(t *testing.T) {
+	s := result.Map(result.NewVal(12), strconv.Itoa).
+		OrPanic("Unexpected error")
+	assert.Equal(t, "12", s)
+}
+
+func TestMapErrorInput(t *testing.T) {
+	v := result.Map(result.ValErrorf[int]("Expected error"), func(i int) string {
+		t.Error("f called on an error Val")
+		return ""
+	})
+	assert.EqualError(t, v, "Expected error")
+}
+
+func validateAge(age int) result.Status {
+	if age < 0 {
+		return result.Errorf("age must be non-negative")
+	}
+	return result.Ok()
+}
+
+func TestMapStatus(t *testing.T) {
+	assert.True(t, result.MapStatus(result.NewVal(1), validateAge).Ok())
+	assert.EqualError(t, result.MapStatus(result.NewVal(-1), validateAge), "age must be non-negative")
+	assert.EqualError(t, result.MapStatus(result.ValErrorf[int]("Expected error"), validateAge), "Expected error")
+}
+
+func parseInt(s string) result.Val[int] {
+	return result.TryVal(strconv.Atoi(s))
+}
+
+func TestChain(t *testing.T) {
+	i := result.Chain(result.NewVal("12"), parseInt).
+		OrPanic("Unexpected error")
+	assert.Equal(t, 12, i)
+	assert.EqualError(t, result.Chain(result.NewVal("x"), parseInt), `strconv.Atoi: parsing "x": invalid syntax`)
+	assert.EqualError(
+		t,
+		result.Chain(result.ValErrorf[string]("Expected error"), func(s string) result.Val[int] {
+			t.Error("f called on an error Val")
+			return result.NewVal(0)
+		}),
+		"Expected error",
+	)
+}
+
+func TestChainStatus(t *testing.T) {
+	assert.True(t, result.ChainStatus(parseInt("1"), validateAge).Ok())
+	assert.EqualError(t, result.ChainStatus(parseInt("-1"), validateAge), "age must be non-negative")
+	assert.EqualError(t, result.ChainStatus(parseInt("x"), validateAge), `strconv.Atoi: parsing "x": invalid syntax`)
+}
+
+func nonNegative(i int) bool {
+	return i >= 0
+}
+
+func TestFilter(t *testing.T) {
+	assert.Equal(t, 1, result.Filter(result.NewVal(1), nonNegative, "age must be non-negative").OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Filter(result.NewVal(-1), nonNegative, "age must be non-negative"), "age must be non-negative")
+	assert.EqualError(
+		t,
+		result.Filter(result.ValErrorf[int]("Expected error"), func(int) bool {
+			t.Error("predicate called on an error Val")
+			return true
+		}, "age must be non-negative"),
+		"Expected error",
+	)
+}
+
+func domainError(e error) error {
+	return fmt.Errorf("user service unavailable: %w", e)
+}
+
+func TestMapError(t *testing.T) {
+	v := result.MapError(result.ValError[int](io.EOF), domainError)
+	assert.EqualError(t, v, "user service unavailable: EOF")
+	assert.True(t, errors.Is(v, io.EOF))
+
+	assert.Equal(t, 1, result.MapError(result.NewVal(1), func(e error) error {
+		t.Error("f called on an ok Val")
+		return e
+	}).OrPanic("Unexpected error"))
+}
+
+func TestMapErrorStatus(t *testing.T) {
+	s := result.MapErrorStatus(result.Error(io.EOF), domainError)
+	assert.EqualError(t, s, "user service unavailable: EOF")
+	assert.True(t, result.MapErrorStatus(result.Ok(), func(e error) error {
+		t.Error("f called on an ok Status")
+		return e
+	}).Ok())
+}
+
+func TestTap(t *testing.T) {
+	seen := []string{}
+	ok := result.NewVal(1)
+	fail := result.ValErrorf[int]("Expected error")
+	onVal := func(i int) {
+		seen = append(seen, fmt.Sprint(i))
+	}
+	onErr := func(e error) {
+		seen = append(seen, e.Error())
+	}
+	assert.Equal(t, ok, result.Tap(ok, onVal))
+	assert.Equal(t, fail, result.Tap(fail, onVal))
+	assert.Equal(t, ok, result.TapErr(ok, onErr))
+	assert.Equal(t, fail, result.TapErr(fail, onErr))
+	assert.Equal(t, []string{"1", "Expected error"}, seen)
+}
+
+func TestTapStatus(t *testing.T) {
+	seen := []string{}
+	ok := result.Ok()
+	fail := result.Errorf("Expected error")
+	onOk := func() {
+		seen = append(seen, "ok")
+	}
+	onErr := func(e error) {
+		seen = append(seen, e.Error())
+	}
+	assert.Equal(t, ok, result.TapStatus(ok, onOk))
+	assert.Equal(t, fail, result.TapStatus(fail, onOk))
+	assert.Equal(t, ok, result.TapStatusErr(ok, onErr))
+	assert.Equal(t, fail, result.TapStatusErr(fail, onErr))
+	assert.Equal(t, []string{"ok", "Expected error"}, seen)
+}
+
+func TestMatch(t *testing.T) {
+	ok := func(i int) string {
+		return fmt.Sprint("value ", i)
+	}
+	fail := func(e error) string {
+		return "error " + e.Error()
+	}
+	assert.Equal(t, "value 1", result.Match(result.NewVal(1), ok, fail))
+	assert.Equal(t, "error Expected error", result.Match(result.ValErrorf[int]("Expected error"), ok, fail))
+}
+
+func TestMatchStatus(t *testing.T) {
+	ok := func() int {
+		return 1
+	}
+	fail := func(error) int {
+		return -1
+	}
+	assert.Equal(t, 1, result.MatchStatus(result.Ok(), ok, fail))
+	assert.Equal(t, -1, result.MatchStatus(result.Errorf("Expected error"), ok, fail))
+}
+
+func TestOrElse(t *testing.T) {
+	assert.Equal(t, 1, result.OrElse(result.NewVal(1), func() result.Val[int] {
+		t.Error("f called on an ok Val")
+		return result.NewVal(2)
+	}).OrPanic("Unexpected error"))
+	assert.Equal(t, 2, result.OrElse(result.ValErrorf[int]("Expected error"), func() result.Val[int] {
+		return result.NewVal(2)
+	}).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.OrElse(result.ValErrorf[int]("Error a"), func() result.Val[int] {
+		return result.ValErrorf[int]("Error b")
+	}), "Error b")
+}
+
+func TestOrElseStatus(t *testing.T) {
+	assert.True(t, result.OrElseStatus(result.Ok(), func() result.Status {
+		t.Error("f called on an ok Status")
+		return result.Errorf("Unexpected error")
+	}).Ok())
+	assert.True(t, result.OrElseStatus(result.Errorf("Expected error"), result.Ok).Ok())
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, 1, result.Flatten(result.NewVal(result.NewVal(1))).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Flatten(result.NewVal(result.ValErrorf[int]("Inner error"))), "Inner error")
+	assert.EqualError(t, result.Flatten(result.ValErrorf[result.Val[int]]("Outer error")), "Outer error")
+}
+
+func TestFlattenMap(t *testing.T) {
+	assert.Equal(t, 12, result.Flatten(result.Map(result.NewVal("12"), parseInt)).OrPanic("Unexpected error"))
+	assert.EqualError(
+		t,
+		result.Flatten(result.Map(result.NewVal("x"), parseInt)),
+		result.Chain(result.NewVal("x"), parseInt).Error(),
+	)
+}
+
+func TestApply(t *testing.T) {
+	double := result.NewVal(func(i int) string {
+		return strconv.Itoa(i * 2)
+	})
+	assert.Equal(t, "4", result.Apply(double, result.NewVal(2)).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Apply(double, result.ValErrorf[int]("Error x")), "Error x")
+	noFunc := result.ValErrorf[func(int) string]("Error f")
+	assert.EqualError(t, result.Apply(noFunc, result.NewVal(2)), "Error f")
+	assert.EqualError(t, result.Apply(noFunc, result.ValErrorf[int]("Error x")), "Error f")
+}
+
+func TestBindError(t *testing.T) {
+	recoverEOF := func(err error) result.Val[int] {
+		if errors.Is(err, io.EOF) {
+			return result.NewVal(0)
+		}
+		return result.ValError[int](err)
+	}
+	assert.Equal(t, 1, result.BindError(result.NewVal(1), recoverEOF).OrPanic("Unexpected error"))
+	assert.Equal(t, 0, result.BindError(result.ValError[int](io.EOF), recoverEOF).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.BindError(result.ValErrorf[int]("Expected error"), recoverEOF), "Expected error")
+}
@@ -24,6 +24,20 @@ func NewVal[T any](v T) Val[T] {
 	}
 }
 
+// NewValFromFunc returns a new ok Val with the value returned by f. f is assumed not to panic: NewValFromFunc doesn't
+// recover panics, so it has no overhead beyond calling f. Any panic from f passes through to the caller
+func NewValFromFunc[T any](f func() T) Val[T] {
+	return NewVal(f())
+}
+
+// New is a shorter alias for NewVal. Usage:
+//     func square(n int) result.Val[int] {
+//         return result.New(n * n)
+//     }
+func New[T any](v T) Val[T] {
+	return NewVal(v)
+}
+
 // ValError returns a new Val with the given error
 func ValError[T any](err error) Val[T] {
 	v := Val[T]{}
@@ -39,6 +53,23 @@ func ValErrorf[T any](s string, args ...any) Val[T] {
 	return v
 }
 
+// Err is a shorter alias for ValError. Usage:
+//     n, err := strconv.Atoi(s)
+//     if err != nil {
+//         return result.Err[int](err)
+//     }
+func Err[T any](err error) Val[T] {
+	return ValError[T](err)
+}
+
+// Errf is a shorter alias for ValErrorf. Usage:
+//     if b == 0 {
+//         return result.Errf[int]("Cannot divide %v by zero", a)
+//     }
+func Errf[T any](format string, args ...any) Val[T] {
+	return ValErrorf[T](format, args...)
+}
+
 // TryVal encloses a function that returns a value and an error, then returns its result as a Val. Usage:
 //     a := result.TryVal(f()).
 //         OrError("f failed")
@@ -49,6 +80,33 @@ func TryVal[T any](v T, err error) Val[T] {
 	return ValError[T](err)
 }
 
+// DiscardN is like TryVal for a function that returns two values and an error, when only the first value is needed.
+// The second value is discarded. Usage:
+//     a := result.DiscardN(f()).
+//         OrError("f failed")
+func DiscardN[T any, N any](v T, discarded N, err error) Val[T] {
+	return TryVal(v, err)
+}
+
+// DiscardFirst is like TryVal for a function that returns two values and an error, when only the second value is
+// needed. The first value is discarded. Usage:
+//     data := result.DiscardFirst(readPacket(r)). // readPacket returns (n int, data []byte, err error)
+//         OrError("Couldn't read packet")
+func DiscardFirst[T, U any](discarded T, v U, err error) Val[U] {
+	return TryVal(v, err)
+}
+
+// ValFromBool returns an ok Val with v if cond is true, or an error Val with errMsg if it's false. Usage:
+//     v, ok := m[key]
+//     name := result.ValFromBool(ok, v, "Key not found").
+//         OrError("Couldn't get name")
+func ValFromBool[T any](cond bool, v T, errMsg string) Val[T] {
+	if cond {
+		return NewVal(v)
+	}
+	return ValErrorf[T]("%s", errMsg)
+}
+
 // FromSlice returns a Val containing the value from slice s at position i, if i is within the bounds of s. If i is out
 // of bounds, FromSlice returns an error Val
 func FromSlice[T any](s []T, i int) Val[T] {
@@ -85,7 +143,20 @@ func (v Val[T]) OrError(e string) T {
 		return v.v
 	}
 	panic(panicToError{
-		err: fmt.Errorf("%v: %v", e, v.err),
+		err: fmt.Errorf("%v: %w", e, v.err),
+	})
+}
+
+// OrErrorf is like OrError, but builds the explanation from a format string and arguments, like fmt.Sprintf. The
+// explanation is only formatted if the Val is an error. Usage:
+//     u := fetchUser(id).
+//         OrErrorf("Couldn't fetch user %v", id)
+func (v Val[T]) OrErrorf(format string, args ...any) T {
+	if v.err == nil {
+		return v.v
+	}
+	panic(panicToError{
+		err: fmt.Errorf("%v: %w", fmt.Sprintf(format, args...), v.err),
 	})
 }
 
@@ -126,7 +197,18 @@ func (v Val[T]) OrPanic(p string) T {
 	if v.err == nil {
 		return v.v
 	}
-	panic(fmt.Errorf("%v: %v", p, v.err))
+	panic(fmt.Errorf("%v: %w", p, v.err))
+}
+
+// Must returns the value of v if it's ok. Otherwise, it panics with v's error, unchanged. Like OrPanic, this panic will
+// not be caught by Handle, HandleError, or HandleReturn. It's meant for initialization and test setup, where an error is
+// a programming mistake, like template.Must. Usage:
+//     var defaultConfig = result.Must(parseConfig(defaultConfigText))
+func Must[T any](v Val[T]) T {
+	if v.err != nil {
+		panic(v.err)
+	}
+	return v.v
 }
 
 // OrUse returns the underlying value if the Val is ok. Otherwise, it substitutes in the given value s. Usage:
@@ -140,3 +222,49 @@ func (v Val[T]) OrUse(s T) T {
 	}
 	return s
 }
+
+// OrUseFunc is like OrUse, but only calls f to compute the substitute value if the Val is an error. Use it when the
+// substitute is expensive to compute. Usage:
+//     cfg := loadUserConfig().OrUseFunc(loadDefaultConfig)
+func (v Val[T]) OrUseFunc(f func() T) T {
+	if v.err == nil {
+		return v.v
+	}
+	return f()
+}
+
+// OrUseFunc returns the underlying value of v if it's ok. Otherwise, it returns the result of f. f is only called if v
+// is an error
+func OrUseFunc[T any](v Val[T], f func() T) T {
+	return v.OrUseFunc(f)
+}
+
+// OrZero returns the underlying value if the Val is ok. Otherwise, it returns the zero value of T. Usage:
+//     count := countVisits(page).OrZero()
+func (v Val[T]) OrZero() T {
+	if v.err == nil {
+		return v.v
+	}
+	var zero T
+	return zero
+}
+
+// Value returns the underlying value and true if the Val is ok. Otherwise, it returns the zero value of T and false,
+// like a map lookup. Usage:
+//     if u, ok := findUser(id).Value(); ok {
+//         greet(u)
+//     }
+func (v Val[T]) Value() (T, bool) {
+	return v.OrZero(), v.err == nil
+}
+
+// AndDo calls f with the underlying value if the Val is ok, then returns the Val unchanged. It's the method form of Tap,
+// for side effects in the middle of a chain. Usage:
+//     cfg := parseConfig(data).
+//         AndDo(func(c Config) {
+//             log.Printf("Loaded config %v", c.Name)
+//         }).
+//         OrError("Couldn't parse config")
+func (v Val[T]) AndDo(f func(T)) Val[T] {
+	return Tap(v, f)
+}
@@ -2,6 +2,7 @@ package result_test
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/bmheenan/result"
@@ -160,3 +161,110 @@ func TestFromMapMissingKey(t *testing.T) {
 		"Didn't panic from empty map",
 	)
 }
+
+func TestValAliases(t *testing.T) {
+	assert.Equal(t, result.NewVal(1), result.New(1))
+	assert.Equal(t, result.ValError[int](io.EOF), result.Err[int](io.EOF))
+	assert.EqualError(t, result.Errf[int]("Expected error %v", 1), "Expected error 1")
+}
+
+func TestNewValFromFunc(t *testing.T) {
+	assert.Equal(t, result.NewVal(2), result.NewValFromFunc(func() int {
+		return 2
+	}))
+	assert.PanicsWithValue(t, "Expected panic", func() {
+		result.NewValFromFunc(func() int {
+			panic("Expected panic")
+		})
+	})
+}
+
+func discardReturns(err error) (int, string, error) {
+	return 1, "b", err
+}
+
+func TestDiscardN(t *testing.T) {
+	assert.Equal(t, 1, result.DiscardN(discardReturns(nil)).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.DiscardN(discardReturns(errors.New("Expected error"))), "Expected error")
+}
+
+func TestDiscardFirst(t *testing.T) {
+	assert.Equal(t, "b", result.DiscardFirst(discardReturns(nil)).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.DiscardFirst(discardReturns(errors.New("Expected error"))), "Expected error")
+}
+
+func valOrErrorf(v result.Val[int]) (res result.Val[int]) {
+	defer result.Handle(&res)
+	i := v.OrErrorf("Context %v", 2)
+	return result.NewVal(i)
+}
+
+func TestValOrErrorf(t *testing.T) {
+	assert.Equal(t, 1, valOrErrorf(result.NewVal(1)).OrPanic("Unexpected error"))
+	v := valOrErrorf(result.ValError[int](io.EOF))
+	assert.EqualError(t, v, "Context 2: EOF")
+	assert.True(t, errors.Is(v, io.EOF))
+}
+
+func TestOrUseFunc(t *testing.T) {
+	called := false
+	fallback := func() int {
+		called = true
+		return -1
+	}
+	assert.Equal(t, 1, result.NewVal(1).OrUseFunc(fallback))
+	assert.Equal(t, 1, result.OrUseFunc(result.NewVal(1), fallback))
+	assert.False(t, called)
+	assert.Equal(t, -1, result.OrUseFunc(result.ValErrorf[int]("Expected error"), fallback))
+	assert.True(t, called)
+}
+
+func TestMust(t *testing.T) {
+	assert.Equal(t, 1, result.Must(result.NewVal(1)))
+	assert.PanicsWithError(t, "EOF", func() {
+		result.Must(result.ValError[int](io.EOF))
+	})
+}
+
+func mustInHandle() (res result.Val[int]) {
+	defer result.Handle(&res)
+	return result.NewVal(result.Must(result.ValError[int](io.EOF)))
+}
+
+func TestMustNotHandled(t *testing.T) {
+	defer func() {
+		assert.Equal(t, io.EOF, recover())
+	}()
+	mustInHandle()
+	t.Error("Must's panic was caught by Handle")
+}
+
+func TestValFromBool(t *testing.T) {
+	assert.Equal(t, 1, result.ValFromBool(true, 1, "Unexpected error").OrPanic("Unexpected error"))
+	assert.EqualError(t, result.ValFromBool(false, 1, "100% wrong"), "100% wrong")
+}
+
+func TestOrZero(t *testing.T) {
+	assert.Equal(t, 1, result.NewVal(1).OrZero())
+	assert.Equal(t, 0, result.ValErrorf[int]("Expected error").OrZero())
+	assert.Nil(t, result.ValErrorf[map[string]int]("Expected error").OrZero())
+}
+
+func TestValue(t *testing.T) {
+	i, ok := result.NewVal(1).Value()
+	assert.Equal(t, 1, i)
+	assert.True(t, ok)
+	i, ok = result.ValErrorf[int]("Expected error").Value()
+	assert.Equal(t, 0, i)
+	assert.False(t, ok)
+}
+
+func TestAndDo(t *testing.T) {
+	seen := []int{}
+	record := func(i int) {
+		seen = append(seen, i)
+	}
+	assert.Equal(t, 1, result.NewVal(1).AndDo(record).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.ValErrorf[int]("Expected error").AndDo(record), "Expected error")
+	assert.Equal(t, []int{1}, seen)
+}
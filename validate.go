@@ -0,0 +1,93 @@
+package result
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+)
+
+// Validate checks v with each of validators in order. If they all return ok, Validate returns an ok Val with v.
+// Otherwise, it stops at the first error, and returns an error Val with it. Usage:
+//     valid := result.Validate(u, validateName, validateEmail).
+//         OrError("Invalid user")
+func Validate[T any](v T, validators ...func(T) Status) Val[T] {
+	for _, validate := range validators {
+		if s := validate(v); s.err != nil {
+			return ValError[T](s.err)
+		}
+	}
+	return NewVal(v)
+}
+
+// ValidateAll is like Validate, but checks v with all of validators, even after one returns an error. If any of them
+// return an error, ValidateAll returns an error Val joining all of their errors with errors.Join
+func ValidateAll[T any](v T, validators ...func(T) Status) Val[T] {
+	var errs []error
+	for _, validate := range validators {
+		if s := validate(v); s.err != nil {
+			errs = append(errs, s.err)
+		}
+	}
+	if len(errs) > 0 {
+		return ValError[T](errors.Join(errs...))
+	}
+	return NewVal(v)
+}
+
+// Constraint returns v unchanged if it's ok and its value passes check. If it doesn't, Constraint returns an error Val
+// with errMsg. If v is already an error, check isn't called and the error is passed through. Common checks, like NonZero
+// and InRange, are provided for use as check. Usage:
+//     port := result.Constraint(parsePort(s), result.InRange(1, 65535), "Port out of range").
+//         OrError("Invalid port")
+func Constraint[T any](v Val[T], check func(T) bool, errMsg string) Val[T] {
+	if v.err != nil {
+		return v
+	}
+	if !check(v.v) {
+		return ValError[T](errors.New(errMsg))
+	}
+	return v
+}
+
+// NonZero checks that v isn't the zero value of its type
+func NonZero[T comparable](v T) bool {
+	var zero T
+	return v != zero
+}
+
+// NonNil checks that v isn't nil. Values of types that can't be nil, like ints and structs, always pass
+func NonNil[T any](v T) bool {
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice,
+		reflect.UnsafePointer:
+		return !rv.IsNil()
+	}
+	return true
+}
+
+// InRange returns a check that a value is between min and max, inclusive
+func InRange[T cmp.Ordered](min, max T) func(T) bool {
+	return func(v T) bool {
+		return v >= min && v <= max
+	}
+}
+
+// NonEmpty checks that s has at least one element
+func NonEmpty[T any](s []T) bool {
+	return len(s) > 0
+}
+
+// MinLen returns a check that a slice has at least n elements
+func MinLen[T any](n int) func([]T) bool {
+	return func(s []T) bool {
+		return len(s) >= n
+	}
+}
+
+// MaxLen returns a check that a slice has at most n elements
+func MaxLen[T any](n int) func([]T) bool {
+	return func(s []T) bool {
+		return len(s) <= n
+	}
+}
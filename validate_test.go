@@ -0,0 +1,95 @@
+package result_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+type signup struct {
+	name  string
+	email string
+}
+
+func hasName(s signup) result.Status {
+	if s.name == "" {
+		return result.Errorf("Name is required")
+	}
+	return result.Ok()
+}
+
+func hasEmail(s signup) result.Status {
+	if !strings.Contains(s.email, "@") {
+		return result.Errorf("Email %q is invalid", s.email)
+	}
+	return result.Ok()
+}
+
+func TestValidate(t *testing.T) {
+	ok := signup{name: "a", email: "a@b.c"}
+	assert.Equal(t, ok, result.Validate(ok, hasName, hasEmail).OrPanic("Unexpected error"))
+	assert.Equal(t, ok, result.Validate(ok).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Validate(signup{}, hasName, hasEmail), "Name is required")
+}
+
+func TestValidateAll(t *testing.T) {
+	ok := signup{name: "a", email: "a@b.c"}
+	assert.Equal(t, ok, result.ValidateAll(ok, hasName, hasEmail).OrPanic("Unexpected error"))
+	assert.EqualError(t, result.ValidateAll(signup{name: "a"}, hasName, hasEmail), `Email "" is invalid`)
+	assert.EqualError(t, result.ValidateAll(signup{}, hasName, hasEmail), "Name is required\n"+`Email "" is invalid`)
+}
+
+func TestConstraint(t *testing.T) {
+	positive := func(i int) bool {
+		return i > 0
+	}
+	assert.Equal(t, 1, result.Constraint(result.NewVal(1), positive, "Not positive").OrPanic("Unexpected error"))
+	assert.EqualError(t, result.Constraint(result.NewVal(-1), positive, "Not positive"), "Not positive")
+	assert.EqualError(
+		t,
+		result.Constraint(result.ValErrorf[int]("Expected error"), func(int) bool {
+			t.Error("check called on an error Val")
+			return true
+		}, "Not positive"),
+		"Expected error",
+	)
+}
+
+func TestNonZero(t *testing.T) {
+	assert.True(t, result.NonZero(1))
+	assert.False(t, result.NonZero(""))
+	assert.False(t, result.NonZero(signup{}))
+}
+
+func TestNonNil(t *testing.T) {
+	var p *int
+	var m map[string]int
+	var e error
+	assert.False(t, result.NonNil(p))
+	assert.False(t, result.NonNil(m))
+	assert.False(t, result.NonNil(e))
+	assert.True(t, result.NonNil(new(int)))
+	assert.True(t, result.NonNil(0))
+	assert.True(t, result.NonNil[any](p)) // A typed nil in an interface isn't a nil interface
+}
+
+func TestInRange(t *testing.T) {
+	port := result.InRange(1, 65535)
+	assert.True(t, port(1))
+	assert.True(t, port(65535))
+	assert.False(t, port(0))
+	assert.False(t, result.InRange("b", "d")("e"))
+}
+
+func TestSliceConstraints(t *testing.T) {
+	assert.True(t, result.NonEmpty([]int{1}))
+	assert.False(t, result.NonEmpty([]int{}))
+	assert.True(t, result.MinLen[int](2)([]int{1, 2}))
+	assert.False(t, result.MinLen[int](2)([]int{1}))
+	assert.True(t, result.MaxLen[int](2)([]int{1, 2}))
+	assert.False(t, result.MaxLen[int](2)([]int{1, 2, 3}))
+	v := result.Constraint(result.NewVal([]string{}), result.NonEmpty[string], "No names")
+	assert.EqualError(t, v, "No names")
+}
@@ -26,6 +26,14 @@ func NewVals[T, U any](v0 T, v1 U) Vals[T, U] {
 	}
 }
 
+// New2 is a shorter alias for NewVals. Usage:
+//     func divAndMod(a, b int) result.Vals[int, int] {
+//         return result.New2(a / b, a % b)
+//     }
+func New2[T, U any](v0 T, v1 U) Vals[T, U] {
+	return NewVals(v0, v1)
+}
+
 // ValsError returns a new Vals with the given error
 func ValsError[T, U any](err error) Vals[T, U] {
 	v := Vals[T, U]{}
@@ -68,7 +76,20 @@ func (v Vals[T, U]) OrError(e string) (T, U) {
 		return v.v0, v.v1
 	}
 	panic(panicToError{
-		err: fmt.Errorf("%v: %v", e, v.err),
+		err: fmt.Errorf("%v: %w", e, v.err),
+	})
+}
+
+// OrErrorf is like OrError, but builds the explanation from a format string and arguments, like fmt.Sprintf. The
+// explanation is only formatted if the Vals is an error. Usage:
+//     first, last := employeeNames(id).
+//         OrErrorf("Couldn't lookup names of employee %v", id)
+func (v Vals[T, U]) OrErrorf(format string, args ...any) (T, U) {
+	if v.err == nil {
+		return v.v0, v.v1
+	}
+	panic(panicToError{
+		err: fmt.Errorf("%v: %w", fmt.Sprintf(format, args...), v.err),
 	})
 }
 
@@ -109,7 +130,7 @@ func (v Vals[T, U]) OrPanic(p string) (T, U) {
 	if v.err == nil {
 		return v.v0, v.v1
 	}
-	panic(fmt.Errorf("%v: %v", p, v.err))
+	panic(fmt.Errorf("%v: %w", p, v.err))
 }
 
 // OrUse returns the underlying values if the Vals is ok. Otherwise, it substitutes in the given values s0 and s1.
@@ -125,3 +146,40 @@ func (v Vals[T, U]) OrUse(s0 T, s1 U) (T, U) {
 	}
 	return s0, s1
 }
+
+// OrUseFunc is like OrUse, but only calls f to compute the substitute values if the Vals is an error. Usage:
+//     user, pass := parseFlags().
+//         OrUseFunc(readDefaultCredentials)
+func (v Vals[T, U]) OrUseFunc(f func() (T, U)) (T, U) {
+	if v.err == nil {
+		return v.v0, v.v1
+	}
+	return f()
+}
+
+// Swap returns v with the order of its values flipped. If v is an error, the error is passed through
+func Swap[T, U any](v Vals[T, U]) Vals[U, T] {
+	if v.err != nil {
+		return ValsError[U, T](v.err)
+	}
+	return NewVals(v.v1, v.v0)
+}
+
+// First returns the first value of v as a Val, discarding the second. If v is an error, the error is passed through.
+// Usage:
+//     user := result.First(employeeNames(id)).
+//         OrError("Couldn't lookup employee")
+func First[T, U any](v Vals[T, U]) Val[T] {
+	if v.err != nil {
+		return ValError[T](v.err)
+	}
+	return NewVal(v.v0)
+}
+
+// Second returns the second value of v as a Val, discarding the first. If v is an error, the error is passed through
+func Second[T, U any](v Vals[T, U]) Val[U] {
+	if v.err != nil {
+		return ValError[U](v.err)
+	}
+	return NewVal(v.v1)
+}
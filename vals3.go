@@ -0,0 +1,132 @@
+package result
+
+import (
+	"fmt"
+)
+
+// Vals3 is a result that holds 3 values when ok. Otherwise, it holds an error. It's most useful as a return value for a
+// function that either returns 3 values or an error, e.g:
+//     func lookupUser(id int) result.Vals3[string, string, time.Time] {
+//         // ...
+//         return result.NewVals3(name, email, created)
+//     }
+type Vals3[T, U, V any] struct {
+	base
+	v0 T
+	v1 U
+	v2 V
+}
+
+// NewVals3 returns a new ok Vals3 with the given values v0, v1, and v2. Usage:
+//     return result.NewVals3(name, email, created)
+func NewVals3[T, U, V any](v0 T, v1 U, v2 V) Vals3[T, U, V] {
+	return Vals3[T, U, V]{
+		v0: v0,
+		v1: v1,
+		v2: v2,
+	}
+}
+
+// ValsError3 returns a new Vals3 with the given error. Usage:
+//     if err != nil {
+//         return result.ValsError3[string, string, time.Time](err)
+//     }
+func ValsError3[T, U, V any](err error) Vals3[T, U, V] {
+	v := Vals3[T, U, V]{}
+	v.err = err
+	return v
+}
+
+// ValsErrorf3 returns a new Vals3 with an error made from the given string and arguments. s and args should be the same
+// as what would be provided to fmt.Errorf. Usage:
+//     return result.ValsErrorf3[string, string, time.Time]("No user with id %v", id)
+func ValsErrorf3[T, U, V any](s string, args ...any) Vals3[T, U, V] {
+	v := Vals3[T, U, V]{}
+	v.err = fmt.Errorf(s, args...)
+	return v
+}
+
+// TryVals3 encloses a function that returns three values and an error, then returns its result as a Vals3. Usage:
+//     id, name, created := result.TryVals3(f()).
+//         OrError("f failed")
+func TryVals3[T, U, V any](v0 T, v1 U, v2 V, err error) Vals3[T, U, V] {
+	if err == nil {
+		return NewVals3(v0, v1, v2)
+	}
+	return ValsError3[T, U, V](err)
+}
+
+// OrError returns the underlying values if the Vals3 is ok. Otherwise, it stops execution of the calling function and
+// returns an error. Use e to provide an explanation about what went wrong; it will be included in the returned error.
+//
+// OrError must only be used inside a function that returns an error or a result, and that has already defered Handle or
+// HandleError. Usage:
+//     func userSummary(id int) (res result.Val[string]) {
+//         defer result.Handle(&res)
+//         name, email, created := lookupUser(id). // lookupUser returns a result.Vals3
+//             OrError("Couldn't lookup user")
+//         return result.NewVal(fmt.Sprintf("%v <%v>, since %v", name, email, created))
+//     }
+// If you use OrError without defering Handle or HandleError at the beginning of the function, it will panic
+func (v Vals3[T, U, V]) OrError(e string) (T, U, V) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2
+	}
+	panic(panicToError{
+		err: fmt.Errorf("%v: %w", e, v.err),
+	})
+}
+
+// OrDoAndReturn returns the underlying values if the Vals3 is ok. Otherwise, it executes the provided function f, then
+// returns from the calling function.
+//
+// OrDoAndReturn must only be used inside a function that has already defered Handle, HandleError, or HandleReturn.
+// Usage:
+//     func main() {
+//         defer result.HandleReturn()
+//         host, port, user := parseFlags(). // parseFlags returns a Vals3
+//             OrDoAndReturn(func(e error) {
+//                 fmt.Printf("Couldn't parse flags: %v\n", e)
+//             })
+//         connect(host, port, user)
+//     }
+// If you use OrDoAndReturn without defering Handle, HandleError, or HandleReturn at the beginning of the function, it
+// will panic
+func (v Vals3[T, U, V]) OrDoAndReturn(f func(error)) (T, U, V) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2
+	}
+	f(v.err)
+	panic(panicToReturn{
+		err: v.err,
+	})
+}
+
+// OrPanic returns the underlying values if the Vals3 is ok. Otherwise, it panics. This panic will not be caught by
+// Handle, HandleError, or HandleReturn. Use p to provide extra context about what went wrong; it will be included in
+// the panic. Usage:
+//     func main() {
+//         host, port, user := parseFlags(). // parseFlags returns a Vals3
+//             OrPanic("Couldn't parse flags")
+//         connect(host, port, user)
+//     }
+func (v Vals3[T, U, V]) OrPanic(p string) (T, U, V) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2
+	}
+	panic(fmt.Errorf("%v: %w", p, v.err))
+}
+
+// OrUse returns the underlying values if the Vals3 is ok. Otherwise, it substitutes in the given values s0, s1, and s2.
+// Usage:
+//     func main() {
+//         host, port, user := parseFlags(). // parseFlags returns a Vals3
+//             OrUse("localhost", 8080, "admin")
+//         connect(host, port, user)
+//     }
+func (v Vals3[T, U, V]) OrUse(s0 T, s1 U, s2 V) (T, U, V) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2
+	}
+	return s0, s1, s2
+}
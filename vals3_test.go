@@ -0,0 +1,64 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryVals3(t *testing.T) {
+	a, b, c := result.TryVals3(1, "b", true, nil).OrPanic("Unexpected error")
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "b", b)
+	assert.Equal(t, true, c)
+
+	a, b, c = result.TryVals3(1, "b", true, errors.New("Expected error")).OrUse(2, "c", false)
+	assert.Equal(t, 2, a)
+	assert.Equal(t, "c", b)
+	assert.Equal(t, false, c)
+}
+
+func TestValsError3(t *testing.T) {
+	assert.EqualError(t, result.ValsError3[int, int, int](errors.New("Expected error")), "Expected error")
+	assert.EqualError(t, result.ValsErrorf3[int, int, int]("Expected error %v", 1), "Expected error 1")
+}
+
+func vals3OrError(fail bool) (res result.Vals3[int, string, bool]) {
+	defer result.Handle(&res)
+	v := result.NewVals3(1, "b", true)
+	if fail {
+		v = result.ValsErrorf3[int, string, bool]("Expected error")
+	}
+	a, b, c := v.OrError("Context")
+	return result.NewVals3(a+1, b+"!", !c)
+}
+
+func TestVals3OrError(t *testing.T) {
+	a, b, c := vals3OrError(false).OrPanic("Unexpected error")
+	assert.Equal(t, 2, a)
+	assert.Equal(t, "b!", b)
+	assert.Equal(t, false, c)
+	assert.EqualError(t, vals3OrError(true), "Context: Expected error")
+}
+
+func TestVals3OrDoAndReturn(t *testing.T) {
+	defer result.HandleReturn()
+	result.NewVals3(1, 2, 3).
+		OrDoAndReturn(func(e error) {
+			t.Errorf("Unexpected error: %v", e)
+		})
+	result.ValsErrorf3[int, int, int]("Expected error").
+		OrDoAndReturn(func(e error) {
+			assert.EqualError(t, e, "Expected error")
+		})
+	t.Error("This line should not execute")
+}
+
+func TestVals3OrPanic(t *testing.T) {
+	assert.PanicsWithError(t, "Expected panic: Expected error", func() {
+		result.ValsErrorf3[int, int, int]("Expected error").
+			OrPanic("Expected panic")
+	})
+}
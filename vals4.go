@@ -0,0 +1,134 @@
+package result
+
+import (
+	"fmt"
+)
+
+// Vals4 is a result that holds 4 values when ok. Otherwise, it holds an error. It's most useful as a return value for a
+// function that either returns 4 values or an error, e.g:
+//     func decodeHeader(b []byte) result.Vals4[uint8, uint8, uint16, uint32] {
+//         // ...
+//         return result.NewVals4(version, flags, length, checksum)
+//     }
+type Vals4[T, U, V, W any] struct {
+	base
+	v0 T
+	v1 U
+	v2 V
+	v3 W
+}
+
+// NewVals4 returns a new ok Vals4 with the given values v0, v1, v2, and v3. Usage:
+//     return result.NewVals4(version, flags, length, checksum)
+func NewVals4[T, U, V, W any](v0 T, v1 U, v2 V, v3 W) Vals4[T, U, V, W] {
+	return Vals4[T, U, V, W]{
+		v0: v0,
+		v1: v1,
+		v2: v2,
+		v3: v3,
+	}
+}
+
+// Vals4Error returns a new Vals4 with the given error. Usage:
+//     if err != nil {
+//         return result.Vals4Error[uint8, uint8, uint16, uint32](err)
+//     }
+func Vals4Error[T, U, V, W any](err error) Vals4[T, U, V, W] {
+	v := Vals4[T, U, V, W]{}
+	v.err = err
+	return v
+}
+
+// Vals4Errorf returns a new Vals4 with an error made from the given string and arguments. s and args should be the same
+// as what would be provided to fmt.Errorf. Usage:
+//     return result.Vals4Errorf[uint8, uint8, uint16, uint32]("Header is %v bytes, want 8", len(b))
+func Vals4Errorf[T, U, V, W any](s string, args ...any) Vals4[T, U, V, W] {
+	v := Vals4[T, U, V, W]{}
+	v.err = fmt.Errorf(s, args...)
+	return v
+}
+
+// TryVals4 encloses a function that returns four values and an error, then returns its result as a Vals4. Usage:
+//     version, flags, length, checksum := result.TryVals4(f()).
+//         OrError("f failed")
+func TryVals4[T, U, V, W any](v0 T, v1 U, v2 V, v3 W, err error) Vals4[T, U, V, W] {
+	if err == nil {
+		return NewVals4(v0, v1, v2, v3)
+	}
+	return Vals4Error[T, U, V, W](err)
+}
+
+// OrError returns the underlying values if the Vals4 is ok. Otherwise, it stops execution of the calling function and
+// returns an error. Use e to provide an explanation about what went wrong; it will be included in the returned error.
+//
+// OrError must only be used inside a function that returns an error or a result, and that has already defered Handle or
+// HandleError. Usage:
+//     func readPacket(b []byte) (res result.Val[Packet]) {
+//         defer result.Handle(&res)
+//         version, flags, length, checksum := decodeHeader(b). // decodeHeader returns a result.Vals4
+//             OrError("Couldn't decode header")
+//         return result.NewVal(newPacket(version, flags, length, checksum, b[8:]))
+//     }
+// If you use OrError without defering Handle or HandleError at the beginning of the function, it will panic
+func (v Vals4[T, U, V, W]) OrError(e string) (T, U, V, W) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2, v.v3
+	}
+	panic(panicToError{
+		err: fmt.Errorf("%v: %w", e, v.err),
+	})
+}
+
+// OrDoAndReturn returns the underlying values if the Vals4 is ok. Otherwise, it executes the provided function f, then
+// returns from the calling function.
+//
+// OrDoAndReturn must only be used inside a function that has already defered Handle, HandleError, or HandleReturn.
+// Usage:
+//     func main() {
+//         defer result.HandleReturn()
+//         version, flags, length, checksum := decodeHeader(header). // decodeHeader returns a Vals4
+//             OrDoAndReturn(func(e error) {
+//                 fmt.Printf("Couldn't decode header: %v\n", e)
+//             })
+//         printHeader(version, flags, length, checksum)
+//     }
+// If you use OrDoAndReturn without defering Handle, HandleError, or HandleReturn at the beginning of the function, it
+// will panic
+func (v Vals4[T, U, V, W]) OrDoAndReturn(f func(error)) (T, U, V, W) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2, v.v3
+	}
+	f(v.err)
+	panic(panicToReturn{
+		err: v.err,
+	})
+}
+
+// OrPanic returns the underlying values if the Vals4 is ok. Otherwise, it panics. This panic will not be caught by
+// Handle, HandleError, or HandleReturn. Use p to provide extra context about what went wrong; it will be included in
+// the panic. Usage:
+//     func main() {
+//         version, flags, length, checksum := decodeHeader(header). // decodeHeader returns a Vals4
+//             OrPanic("Couldn't decode header")
+//         printHeader(version, flags, length, checksum)
+//     }
+func (v Vals4[T, U, V, W]) OrPanic(p string) (T, U, V, W) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2, v.v3
+	}
+	panic(fmt.Errorf("%v: %w", p, v.err))
+}
+
+// OrUse returns the underlying values if the Vals4 is ok. Otherwise, it substitutes in the given values s0, s1, s2,
+// and s3. Usage:
+//     func main() {
+//         version, flags, length, checksum := decodeHeader(header). // decodeHeader returns a Vals4
+//             OrUse(1, 0, 0, 0)
+//         printHeader(version, flags, length, checksum)
+//     }
+func (v Vals4[T, U, V, W]) OrUse(s0 T, s1 U, s2 V, s3 W) (T, U, V, W) {
+	if v.err == nil {
+		return v.v0, v.v1, v.v2, v.v3
+	}
+	return s0, s1, s2, s3
+}
@@ -0,0 +1,64 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryVals4(t *testing.T) {
+	a, b, c, d := result.TryVals4(1, "b", true, 4.0, nil).OrPanic("Unexpected error")
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "b", b)
+	assert.Equal(t, true, c)
+	assert.Equal(t, 4.0, d)
+
+	a, b, c, d = result.TryVals4(1, "b", true, 4.0, errors.New("Expected error")).OrUse(2, "c", false, 5.0)
+	assert.Equal(t, 2, a)
+	assert.Equal(t, "c", b)
+	assert.Equal(t, false, c)
+	assert.Equal(t, 5.0, d)
+}
+
+func TestVals4Error(t *testing.T) {
+	assert.EqualError(t, result.Vals4Error[int, int, int, int](errors.New("Expected error")), "Expected error")
+	assert.EqualError(t, result.Vals4Errorf[int, int, int, int]("Expected error %v", 1), "Expected error 1")
+}
+
+func vals4OrError(fail bool) (res result.Vals4[int, int, int, int]) {
+	defer result.Handle(&res)
+	v := result.NewVals4(1, 2, 3, 4)
+	if fail {
+		v = result.Vals4Errorf[int, int, int, int]("Expected error")
+	}
+	a, b, c, d := v.OrError("Context")
+	return result.NewVals4(d, c, b, a)
+}
+
+func TestVals4OrError(t *testing.T) {
+	a, b, c, d := vals4OrError(false).OrPanic("Unexpected error")
+	assert.Equal(t, []int{4, 3, 2, 1}, []int{a, b, c, d})
+	assert.EqualError(t, vals4OrError(true), "Context: Expected error")
+}
+
+func TestVals4OrDoAndReturn(t *testing.T) {
+	defer result.HandleReturn()
+	result.NewVals4(1, 2, 3, 4).
+		OrDoAndReturn(func(e error) {
+			t.Errorf("Unexpected error: %v", e)
+		})
+	result.Vals4Errorf[int, int, int, int]("Expected error").
+		OrDoAndReturn(func(e error) {
+			assert.EqualError(t, e, "Expected error")
+		})
+	t.Error("This line should not execute")
+}
+
+func TestVals4OrPanic(t *testing.T) {
+	assert.PanicsWithError(t, "Expected panic: Expected error", func() {
+		result.Vals4Errorf[int, int, int, int]("Expected error").
+			OrPanic("Expected panic")
+	})
+}
@@ -106,3 +106,51 @@ func TestValsOrUse(t *testing.T) {
 	assert.Equal(t, map[int]string{0: "hello"}, c)
 	assert.Equal(t, 100, d)
 }
+
+func TestNew2(t *testing.T) {
+	assert.Equal(t, result.NewVals(1, "a"), result.New2(1, "a"))
+}
+
+func valsOrErrorf(v result.Vals[int, int]) (res result.Vals[int, int]) {
+	defer result.Handle(&res)
+	a, b := v.OrErrorf("Context %v", 2)
+	return result.NewVals(b, a)
+}
+
+func TestValsOrErrorf(t *testing.T) {
+	a, b := valsOrErrorf(result.NewVals(1, 2)).OrPanic("Unexpected error")
+	assert.Equal(t, 2, a)
+	assert.Equal(t, 1, b)
+	assert.EqualError(t, valsOrErrorf(result.ValsErrorf[int, int]("Expected error")), "Context 2: Expected error")
+}
+
+func TestValsOrUseFunc(t *testing.T) {
+	fallback := func() (string, int) {
+		return "default", -1
+	}
+	s, i := result.NewVals("a", 1).OrUseFunc(func() (string, int) {
+		t.Error("fallback called on an ok Vals")
+		return "", 0
+	})
+	assert.Equal(t, "a", s)
+	assert.Equal(t, 1, i)
+	s, i = result.ValsErrorf[string, int]("Expected error").OrUseFunc(fallback)
+	assert.Equal(t, "default", s)
+	assert.Equal(t, -1, i)
+}
+
+func TestSwap(t *testing.T) {
+	i, s := result.Swap(result.NewVals("a", 1)).OrPanic("Unexpected error")
+	assert.Equal(t, 1, i)
+	assert.Equal(t, "a", s)
+	assert.EqualError(t, result.Swap(result.ValsErrorf[string, int]("Expected error")), "Expected error")
+}
+
+func TestFirstSecond(t *testing.T) {
+	v := result.NewVals("a", 1)
+	assert.Equal(t, "a", result.First(v).OrPanic("Unexpected error"))
+	assert.Equal(t, 1, result.Second(v).OrPanic("Unexpected error"))
+	e := result.ValsErrorf[string, int]("Expected error")
+	assert.EqualError(t, result.First(e), "Expected error")
+	assert.EqualError(t, result.Second(e), "Expected error")
+}
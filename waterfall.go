@@ -0,0 +1,44 @@
+package result
+
+import (
+	"context"
+)
+
+// Waterfall calls each of steps in order, passing initial to the first and the value returned by each step to the
+// next. It stops at the first error Val and returns it. With no steps, it returns initial as an ok Val. Usage:
+//     user := result.Waterfall(raw, normalize, validate, enrich).
+//         OrError("Couldn't process user")
+func Waterfall[T any](initial T, steps ...func(T) Val[T]) Val[T] {
+	v := NewVal(initial)
+	for _, step := range steps {
+		if v = step(v.v); v.err != nil {
+			return v
+		}
+	}
+	return v
+}
+
+// WaterfallStatus calls each of steps in order, and stops at the first error Status and returns it
+func WaterfallStatus(steps ...func() Status) Status {
+	for _, step := range steps {
+		if s := step(); s.err != nil {
+			return s
+		}
+	}
+	return Ok()
+}
+
+// WaterfallCtx is like Waterfall, but passes ctx to each step. It stops and returns an error Val wrapping ctx.Err() if
+// ctx is done before a step is called
+func WaterfallCtx[T any](ctx context.Context, initial T, steps ...func(context.Context, T) Val[T]) Val[T] {
+	v := NewVal(initial)
+	for _, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return ValError[T](err)
+		}
+		if v = step(ctx, v.v); v.err != nil {
+			return v
+		}
+	}
+	return v
+}
@@ -0,0 +1,76 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmheenan/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// waterfallStep returns a step that appends s, or fails if s is empty. It counts its calls in *calls
+func waterfallStep(s string, calls *int) func(string) result.Val[string] {
+	return func(in string) result.Val[string] {
+		*calls++
+		if s == "" {
+			return result.ValErrorf[string]("Failed after %v", in)
+		}
+		return result.NewVal(in + s)
+	}
+}
+
+func TestWaterfallAllSucceed(t *testing.T) {
+	calls := 0
+	s := result.Waterfall("", waterfallStep("a", &calls), waterfallStep("b", &calls), waterfallStep("c", &calls)).
+		OrPanic("Unexpected error")
+	assert.Equal(t, "abc", s)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaterfallFails(t *testing.T) {
+	for i, expected := range []string{"Failed after >", "Failed after >a", "Failed after >ab"} {
+		calls := 0
+		steps := []func(string) result.Val[string]{
+			waterfallStep("a", &calls),
+			waterfallStep("b", &calls),
+			waterfallStep("c", &calls),
+		}
+		steps[i] = waterfallStep("", &calls)
+		assert.EqualError(t, result.Waterfall(">", steps...), expected)
+		assert.Equal(t, i+1, calls)
+	}
+}
+
+func TestWaterfallEmpty(t *testing.T) {
+	assert.Equal(t, "initial", result.Waterfall("initial").OrPanic("Unexpected error"))
+}
+
+func TestWaterfallStatus(t *testing.T) {
+	calls := 0
+	ok := func() result.Status {
+		calls++
+		return result.Ok()
+	}
+	fail := func() result.Status {
+		calls++
+		return result.Errorf("Expected error")
+	}
+	assert.True(t, result.WaterfallStatus(ok, ok).Ok())
+	assert.True(t, result.WaterfallStatus().Ok())
+	calls = 0
+	assert.EqualError(t, result.WaterfallStatus(ok, fail, ok), "Expected error")
+	assert.Equal(t, 2, calls)
+}
+
+func TestWaterfallCtx(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "!")
+	step := func(ctx context.Context, s string) result.Val[string] {
+		return result.NewVal(s + ctx.Value(key{}).(string))
+	}
+	assert.Equal(t, "a!!", result.WaterfallCtx(ctx, "a", step, step).OrPanic("Unexpected error"))
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.EqualError(t, result.WaterfallCtx(ctx, "a", step), "context canceled")
+}